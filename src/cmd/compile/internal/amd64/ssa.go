@@ -499,7 +499,8 @@ func ssaGenValue(s *ssagen.State, v *ssa.Value) {
 		p.To.Type = obj.TYPE_REG
 		p.To.Reg = v.Reg()
 
-	case ssa.OpAMD64ADDQcarry, ssa.OpAMD64ADCQ:
+	case ssa.OpAMD64ADDQcarry, ssa.OpAMD64ADDLcarry, ssa.OpAMD64ADCQ,
+		ssa.OpAMD64MULQflags, ssa.OpAMD64MULLflags:
 		r := v.Reg0()
 		r0 := v.Args[0].Reg()
 		r1 := v.Args[1].Reg()
@@ -520,7 +521,7 @@ func ssaGenValue(s *ssagen.State, v *ssa.Value) {
 			v.Fatalf("output not in same register as an input %s", v.LongString())
 		}
 
-	case ssa.OpAMD64SUBQborrow, ssa.OpAMD64SBBQ:
+	case ssa.OpAMD64SUBQborrow, ssa.OpAMD64SUBLborrow, ssa.OpAMD64SBBQ:
 		p := s.Prog(v.Op.Asm())
 		p.From.Type = obj.TYPE_REG
 		p.From.Reg = v.Args[1].Reg()
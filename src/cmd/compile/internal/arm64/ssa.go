@@ -1478,7 +1478,8 @@ func ssaGenValue(s *ssagen.State, v *ssa.Value) {
 		ssa.OpARM64NotGreaterThanF,
 		ssa.OpARM64NotGreaterEqualF,
 		ssa.OpARM64LessThanNoov,
-		ssa.OpARM64GreaterEqualNoov:
+		ssa.OpARM64GreaterEqualNoov,
+		ssa.OpARM64Overflow:
 		// generate boolean values using CSET
 		p := s.Prog(arm64.ACSET)
 		p.From.Type = obj.TYPE_SPECIAL // assembler encodes conditional bits in Offset
@@ -1572,6 +1573,7 @@ var condBits = map[ssa.Op]arm64.SpecialOperand{
 
 	ssa.OpARM64LessThanNoov:     arm64.SPOP_MI, // Less than but without honoring overflow
 	ssa.OpARM64GreaterEqualNoov: arm64.SPOP_PL, // Greater than or equal to but without honoring overflow
+	ssa.OpARM64Overflow:         arm64.SPOP_VS, // Signed overflow
 }
 
 var blockJump = map[ssa.BlockKind]struct {
@@ -1599,6 +1601,7 @@ var blockJump = map[ssa.BlockKind]struct {
 	ssa.BlockARM64FGT:    {arm64.ABGT, arm64.ABLE},
 	ssa.BlockARM64LTnoov: {arm64.ABMI, arm64.ABPL},
 	ssa.BlockARM64GEnoov: {arm64.ABPL, arm64.ABMI},
+	ssa.BlockARM64VS:     {arm64.ABVS, arm64.ABVC},
 }
 
 // To model a 'LEnoov' ('<=' without overflow checking) branching.
@@ -1636,7 +1639,8 @@ func ssaGenBlock(s *ssagen.State, b, next *ssa.Block) {
 		ssa.BlockARM64ZW, ssa.BlockARM64NZW,
 		ssa.BlockARM64FLT, ssa.BlockARM64FGE,
 		ssa.BlockARM64FLE, ssa.BlockARM64FGT,
-		ssa.BlockARM64LTnoov, ssa.BlockARM64GEnoov:
+		ssa.BlockARM64LTnoov, ssa.BlockARM64GEnoov,
+		ssa.BlockARM64VS:
 		jmp := blockJump[b.Kind]
 		var p *obj.Prog
 		switch next {
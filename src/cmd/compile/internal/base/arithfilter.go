@@ -0,0 +1,71 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package base
+
+import (
+	"cmd/internal/pkgpattern"
+	"log"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// An ArithFilter selects code by package and file for the -panikint.include
+// and -panikint.exclude flags. Their value is a comma-separated list of
+// package patterns in the style of go list, such as example.com/dep/...,
+// and file globs, such as *_gen.go or internal/gen/*.go. An entry that ends
+// in .go or contains one of the characters *?[ is a file glob.
+type ArithFilter struct {
+	pkgs  []func(string) bool
+	files []string
+}
+
+// parseArithFilter parses the value list of the flag named name.
+func parseArithFilter(name, list string) ArithFilter {
+	var f ArithFilter
+	for _, p := range strings.Split(list, ",") {
+		p = strings.TrimSpace(p)
+		switch {
+		case p == "":
+		case strings.HasSuffix(p, ".go") || strings.ContainsAny(p, "*?["):
+			if _, err := path.Match(p, ""); err != nil {
+				log.Fatalf("-%s: malformed file glob %q", name, p)
+			}
+			f.files = append(f.files, p)
+		default:
+			f.pkgs = append(f.pkgs, pkgpattern.MatchPattern(p))
+		}
+	}
+	return f
+}
+
+// Match reports whether f selects code of the package with import path pkg
+// in the file filename. A file glob matches if it matches a trailing
+// sequence of the path elements of filename, so that gen/*.go matches
+// /src/app/gen/tables.go but not /src/app/gen/sub/tables.go.
+func (f *ArithFilter) Match(pkg, filename string) bool {
+	for _, match := range f.pkgs {
+		if match(pkg) {
+			return true
+		}
+	}
+	if len(f.files) == 0 || filename == "" {
+		return false
+	}
+	filename = filepath.ToSlash(filename)
+	for _, glob := range f.files {
+		for name := filename; ; {
+			if ok, _ := path.Match(glob, name); ok {
+				return true
+			}
+			i := strings.Index(name, "/")
+			if i < 0 {
+				break
+			}
+			name = name[i+1:]
+		}
+	}
+	return false
+}
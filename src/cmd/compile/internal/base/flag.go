@@ -128,7 +128,17 @@ type CmdFlags struct {
 	WB                 bool         "help:\"enable write barrier\"" // TODO: remove
 	PgoProfile         string       "help:\"read profile or pre-process profile from `file`\""
 	ErrorURL           bool         "help:\"print explanatory URL with error message if applicable\""
-	TruncationDetect   bool         "help:\"enable integer truncation detection (default: true)\""
+	OverflowDetect     bool         "help:\"enable integer overflow detection for addition, subtraction, multiplication and division (default: true)\""
+	NegationDetect     bool         "help:\"enable integer overflow detection for negation (default: true)\""
+	TruncationDetect   bool         "help:\"enable integer truncation detection (default: false)\""
+	OverflowUintptr    bool         "help:\"enable integer overflow detection for uintptr arithmetic (default: true)\""
+	ArithRecover       bool         "help:\"report failed integer overflow and truncation checks and continue with the wrapped value\""
+	ShiftDetect        bool         "help:\"enable detection of left shifts that lose set bits or change the sign\""
+	FloatConvDetect    bool         "help:\"enable detection of NaN, infinite or out-of-range floats converted to integers (default: false)\""
+	Int32Detect        bool         "help:\"enable detection of int and uint values that do not fit in 32 bits on 64-bit targets, as on 32-bit ones (default: false)\""
+	PanikintInclude    string       "flag:\"panikint.include\" help:\"check arithmetic in the packages and files matching the comma-separated `patterns`, even if excluded by default\""
+	PanikintExclude    string       "flag:\"panikint.exclude\" help:\"do not check arithmetic in the packages and files matching the comma-separated `patterns`\""
+	PanikintStrict     bool         "flag:\"panikint.strict\" help:\"report stale //panikint:ignore and //go:nooverflowcheck directives and arithmetic checks that always fail as errors\""
 
 	// Configuration derived from flags; not a flag itself.
 	Cfg struct {
@@ -141,6 +151,8 @@ type CmdFlags struct {
 		PackageFile  map[string]string        // set by -importcfg; nil means not in use
 		CoverageInfo *covcmd.CoverFixupConfig // set by -coveragecfg
 		SpectreIndex bool                     // set by -spectre=index or -spectre=all
+		ArithInclude ArithFilter              // set by -panikint.include
+		ArithExclude ArithFilter              // set by -panikint.exclude
 		// Whether we are adding any sort of code instrumentation, such as
 		// when the race detector is enabled.
 		Instrumenting bool
@@ -177,7 +189,13 @@ func ParseFlags() {
 	Flag.LinkShared = &Ctxt.Flag_linkshared
 	Flag.Shared = &Ctxt.Flag_shared
 	Flag.WB = true
+	// The default check classes must match panikintDefaultChecks
+	// in cmd/go/internal/work.
+	Flag.OverflowDetect = true
+	Flag.NegationDetect = true
 	Flag.TruncationDetect = false
+	Flag.OverflowUintptr = true
+	Flag.FloatConvDetect = false
 
 	Debug.ConcurrentOk = true
 	Debug.CompressInstructions = 1
@@ -222,6 +240,9 @@ func ParseFlags() {
 
 	Ctxt.Std = Flag.Std
 
+	Flag.Cfg.ArithInclude = parseArithFilter("panikint.include", Flag.PanikintInclude)
+	Flag.Cfg.ArithExclude = parseArithFilter("panikint.exclude", Flag.PanikintExclude)
+
 	// Three inputs govern loop iteration variable rewriting, hash, experiment, flag.
 	// The loop variable rewriting is:
 	// IF non-empty hash, then hash determines behavior (function+line match) (*)
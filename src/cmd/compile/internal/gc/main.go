@@ -383,6 +383,8 @@ func Main(archInit func(*ssagen.ArchInfo)) {
 	}
 
 	ssagen.CheckLargeStacks()
+	ssagen.CheckArithDirectives()
+	ssagen.CheckFailingArithChecks()
 	typecheck.CheckFuncStack()
 
 	if len(compilequeue) != 0 {
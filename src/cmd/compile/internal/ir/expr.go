@@ -38,6 +38,7 @@ const (
 	miniExprBounded
 	miniExprImplicit // for use by implementations; not supported by every Expr
 	miniExprCheckPtr
+	miniExprNoArithCheck
 )
 
 func (*miniExpr) isExpr() {}
@@ -54,6 +55,11 @@ func (n *miniExpr) Init() Nodes           { return n.init }
 func (n *miniExpr) PtrInit() *Nodes       { return &n.init }
 func (n *miniExpr) SetInit(x Nodes)       { n.init = x }
 
+// NoArithCheck reports whether the overflow or truncation check on
+// this arithmetic operation or conversion has been suppressed.
+func (n *miniExpr) NoArithCheck() bool     { return n.flags&miniExprNoArithCheck != 0 }
+func (n *miniExpr) SetNoArithCheck(b bool) { n.flags.set(miniExprNoArithCheck, b) }
+
 // An AddStringExpr is a string concatenation List[0] + List[1] + ... + List[len(List)-1].
 type AddStringExpr struct {
 	miniExpr
@@ -456,8 +456,67 @@ const (
 
 	RegisterParams // TODO(register args) remove after register abi is working
 
+	NoOverflowCheck // func's arithmetic must not be instrumented with overflow checks
 )
 
+// ArithChecks is a set of arithmetic checks that a //panikint:ignore
+// directive suppresses.
+type ArithChecks uint8
+
+const (
+	OverflowChecks   ArithChecks = 1 << iota // overflow checks on arithmetic operations
+	TruncationChecks                         // truncation checks on integer conversions
+)
+
+// An ArithDirective is a //panikint:ignore or //go:nooverflowcheck
+// directive of the package being compiled. The backend records the
+// checks it suppressed, so that stale directives can be reported.
+type ArithDirective struct {
+	Pos    src.XPos
+	Name   string // "panikint:ignore" or "go:nooverflowcheck"
+	Checks ArithChecks
+
+	// File is set if the directive applies to the whole file.
+	// Otherwise, Ranges are the source ranges of the statements and
+	// function declarations it applies to.
+	File   bool
+	Ranges []ArithRange
+
+	// Generic is set if all the code the directive applies to is in
+	// generic functions, which may not be instantiated in the package.
+	Generic bool
+
+	// Used are the checks the directive suppressed, and Disabled the
+	// checks it would have suppressed if their class were enabled.
+	// They are set by the backend.
+	Used, Disabled ArithChecks
+}
+
+// An ArithRange is the source range of a statement or function
+// declaration, from its first to its last character.
+type ArithRange struct {
+	Start, End src.Pos
+}
+
+// Covers reports whether d applies to the code at p.
+func (d *ArithDirective) Covers(p src.Pos) bool {
+	if p.Filename() != base.Ctxt.PosTable.Pos(d.Pos).Filename() {
+		return false
+	}
+	if d.File {
+		return true
+	}
+	before := func(p, q src.Pos) bool {
+		return p.Line() < q.Line() || p.Line() == q.Line() && p.Col() <= q.Col()
+	}
+	for _, r := range d.Ranges {
+		if before(r.Start, p) && before(p, r.End) {
+			return true
+		}
+	}
+	return false
+}
+
 var BlankNode *Name
 
 func IsConst(n Node, ct constant.Kind) bool {
@@ -31,6 +31,9 @@ type Package struct {
 	// Cgo directives.
 	CgoPragmas [][]string
 
+	// Arithmetic check suppression directives, listed in source order.
+	ArithDirectives []*ArithDirective
+
 	// Variables with //go:embed lines.
 	Embeds []*Name
 
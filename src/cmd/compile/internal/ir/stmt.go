@@ -117,6 +117,9 @@ type AssignOpStmt struct {
 	AsOp   Op // OADD etc
 	Y      Node
 	IncDec bool // actually ++ or --
+
+	// NoArithCheck is set if AsOp's overflow check is suppressed.
+	NoArithCheck bool
 }
 
 func NewAssignOpStmt(pos src.XPos, asOp Op, x, y Node) *AssignOpStmt {
@@ -57,9 +57,22 @@ type symsStruct struct {
 	Panicnildottype           *obj.LSym
 	Panicoverflow             *obj.LSym
 	// Fork-specific detailed panic symbols retained.
-	Panicoverflowdetailed *obj.LSym
-	Panictruncate         *obj.LSym
-	Panictruncatedetailed *obj.LSym
+	Panicoverflowdetailed  *obj.LSym
+	Panictruncate          *obj.LSym
+	Panictruncatedetailed  *obj.LSym
+	Panicfloatconvdetailed *obj.LSym
+	Panicarithnamed        *obj.LSym
+	Panicfloatconvnamed    *obj.LSym
+	Panicrange             *obj.LSym
+	Panicrangefloat        *obj.LSym
+	// Report-and-continue counterparts used with -arithrecover.
+	Reportoverflowdetailed  *obj.LSym
+	Reporttruncatedetailed  *obj.LSym
+	Reportfloatconvdetailed *obj.LSym
+	Reportarithnamed        *obj.LSym
+	Reportfloatconvnamed    *obj.LSym
+	Reportrange             *obj.LSym
+	Reportrangefloat        *obj.LSym
 	// Upstream symbol for SIMD immediate validation
 	PanicSimdImm   *obj.LSym
 	Racefuncenter  *obj.LSym
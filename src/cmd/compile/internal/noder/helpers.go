@@ -7,6 +7,7 @@ package noder
 import (
 	"go/constant"
 
+	"cmd/compile/internal/base"
 	"cmd/compile/internal/ir"
 	"cmd/compile/internal/syntax"
 	"cmd/compile/internal/typecheck"
@@ -59,6 +60,25 @@ func FixValue(typ *types.Type, val constant.Value) constant.Value {
 	return val
 }
 
+// checkArithRange reports an error at pos if the constant val of type typ
+// lies outside the range declared for typ by a //panikint:range directive.
+func checkArithRange(pos src.XPos, typ *types.Type, val constant.Value) {
+	a := typ.Arith()
+	if a == nil || !a.Range || val.Kind() != constant.Int {
+		return
+	}
+	var v uint64
+	if typ.IsSigned() {
+		x, _ := constant.Int64Val(val)
+		v = uint64(x)
+	} else {
+		v, _ = constant.Uint64Val(val)
+	}
+	if !a.InRange(typ, v) {
+		base.ErrorfAt(pos, 0, "constant %v out of range %s of %s", val, a.RangeString(typ), a.Name)
+	}
+}
+
 // Expressions
 
 func Addr(pos src.XPos, x ir.Node) *ir.AddrExpr {
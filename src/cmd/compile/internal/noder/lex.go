@@ -35,7 +35,8 @@ const (
 		ir.Systemstack |
 		ir.Nowritebarrier |
 		ir.Nowritebarrierrec |
-		ir.Yeswritebarrierrec
+		ir.Yeswritebarrierrec |
+		ir.NoOverflowCheck
 )
 
 func pragmaFlag(verb string) ir.PragmaFlag {
@@ -75,6 +76,8 @@ func pragmaFlag(verb string) ir.PragmaFlag {
 		return ir.UintptrEscapes | ir.UintptrKeepAlive // implies UintptrKeepAlive
 	case "go:registerparams": // TODO(register args) remove after register abi is working
 		return ir.RegisterParams
+	case "go:nooverflowcheck":
+		return ir.NoOverflowCheck
 	}
 	return 0
 }
@@ -143,6 +146,40 @@ func (p *noder) pragcgo(pos syntax.Pos, text string) {
 	p.pragcgobuf = append(p.pragcgobuf, f)
 }
 
+// pragpanikint is called concurrently if files are parsed concurrently.
+func (p *noder) pragpanikint(pos syntax.Pos, blankLine bool, text string) {
+	f := strings.Fields(text)
+	if f[0] != "panikint:ignore" {
+		p.error(syntax.Error{Pos: pos, Msg: fmt.Sprintf("unknown directive //%s", f[0])})
+		return
+	}
+
+	var checks ir.ArithChecks
+	for _, kind := range f[1:] {
+		switch kind {
+		case "overflow":
+			checks |= ir.OverflowChecks
+		case "truncation":
+			checks |= ir.TruncationChecks
+		default:
+			p.error(syntax.Error{Pos: pos, Msg: fmt.Sprintf("unknown check %q in //panikint:ignore directive", kind)})
+			return
+		}
+	}
+	if checks == 0 {
+		p.error(syntax.Error{Pos: pos, Msg: "usage: //panikint:ignore overflow|truncation..."})
+		return
+	}
+
+	// A directive on a line by itself applies to the next line;
+	// a trailing one applies to the line it is on.
+	line := pos.Line()
+	if blankLine {
+		line++
+	}
+	p.arithIgnores = append(p.arithIgnores, arithIgnore{pos, line, checks})
+}
+
 // pragmaFields is similar to strings.FieldsFunc(s, isSpace)
 // but does not split when inside double quoted regions and always
 // splits before the start and after the end of a double quoted region.
@@ -315,6 +315,7 @@ func (l *linker) relocTypeExt(w *pkgbits.Encoder, name *ir.Name) {
 	typ := name.Type()
 
 	l.pragmaFlag(w, name.Pragma())
+	l.typeArith(w, typ)
 
 	// For type T, export the index of type descriptor symbols of T and *T.
 	l.lsymIdx(w, "", reflectdata.TypeLinksym(typ))
@@ -327,6 +328,19 @@ func (l *linker) relocTypeExt(w *pkgbits.Encoder, name *ir.Name) {
 	}
 }
 
+// typeArith writes the //panikint: directives of typ, as
+// writer.typeArith does.
+func (l *linker) typeArith(w *pkgbits.Encoder, typ *types.Type) {
+	a := typ.Arith()
+	if w.Bool(a != nil) {
+		w.Bool(a.Wrapping)
+		if w.Bool(a.Range) {
+			w.Uint64(a.Lo)
+			w.Uint64(a.Hi)
+		}
+	}
+}
+
 func (l *linker) relocVarExt(w *pkgbits.Encoder, name *ir.Name) {
 	w.Sync(pkgbits.SyncVarExt)
 	l.linkname(w, name)
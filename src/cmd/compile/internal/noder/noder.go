@@ -97,10 +97,11 @@ func trimFilename(b *syntax.PosBase) string {
 
 // noder transforms package syntax's AST into a Node tree.
 type noder struct {
-	file       *syntax.File
-	linknames  []linkname
-	pragcgobuf [][]string
-	err        chan syntax.Error
+	file         *syntax.File
+	linknames    []linkname
+	pragcgobuf   [][]string
+	arithIgnores []arithIgnore
+	err          chan syntax.Error
 }
 
 // linkname records a //go:linkname or //go:linknamestd directive.
@@ -111,6 +112,13 @@ type linkname struct {
 	remote string
 }
 
+// arithIgnore records a //panikint:ignore directive.
+type arithIgnore struct {
+	pos    syntax.Pos
+	line   uint // line of the statement the directive applies to
+	checks ir.ArithChecks
+}
+
 var unOps = [...]ir.Op{
 	syntax.Recv: ir.ORECV,
 	syntax.Mul:  ir.ODEREF,
@@ -173,6 +181,7 @@ type pragmas struct {
 	Embeds     []pragmaEmbed
 	WasmImport *WasmImport
 	WasmExport *WasmExport
+	Arith      []pragmaArith // //panikint: directives of a type declaration
 }
 
 // WasmImport stores metadata associated with the //go:wasmimport pragma
@@ -193,6 +202,14 @@ type pragmaPos struct {
 	Pos  syntax.Pos
 }
 
+// pragmaArith records a //panikint:wrapping or //panikint:range
+// directive, which applies to the defined integer type declared next.
+type pragmaArith struct {
+	Pos  syntax.Pos
+	Verb string
+	Arg  string // lo..hi for //panikint:range
+}
+
 type pragmaEmbed struct {
 	Pos      syntax.Pos
 	Patterns []string
@@ -215,6 +232,9 @@ func (p *noder) checkUnusedDuringParse(pragma *pragmas) {
 	if pragma.WasmExport != nil {
 		p.error(syntax.Error{Pos: pragma.WasmExport.Pos, Msg: "misplaced go:wasmexport directive"})
 	}
+	for _, a := range pragma.Arith {
+		p.error(syntax.Error{Pos: a.Pos, Msg: fmt.Sprintf("misplaced //%s directive", a.Verb)})
+	}
 }
 
 // pragma is called concurrently if files are parsed concurrently.
@@ -235,6 +255,32 @@ func (p *noder) pragma(pos syntax.Pos, blankLine bool, text string, old syntax.P
 		panic("unreachable")
 	}
 
+	if f := strings.Fields(text); f[0] == "panikint:wrapping" || f[0] == "panikint:range" {
+		// Unlike the others, these directives apply to a declaration.
+		switch {
+		case !blankLine:
+			p.error(syntax.Error{Pos: pos, Msg: "misplaced compiler directive"})
+		case f[0] == "panikint:wrapping" && len(f) != 1:
+			p.error(syntax.Error{Pos: pos, Msg: "usage: //panikint:wrapping"})
+		case f[0] == "panikint:range" && (len(f) != 2 || !strings.Contains(f[1], "..")):
+			p.error(syntax.Error{Pos: pos, Msg: "usage: //panikint:range lo..hi"})
+		default:
+			a := pragmaArith{Pos: pos, Verb: f[0]}
+			if len(f) > 1 {
+				a.Arg = f[1]
+			}
+			pragma.Arith = append(pragma.Arith, a)
+		}
+		return pragma
+	}
+
+	if strings.HasPrefix(text, "panikint:") {
+		// Other panikint directives apply to statements, not
+		// declarations, so they don't contribute to the pragma.
+		p.pragpanikint(pos, blankLine, text)
+		return old
+	}
+
 	if !blankLine {
 		// directive must be on line by itself
 		p.error(syntax.Error{Pos: pos, Msg: "misplaced compiler directive"})
@@ -784,6 +784,7 @@ func (pr *pkgReader) objIdxMayFail(idx index, implicits, explicits []*types.Type
 		name := do(ir.OLITERAL, false)
 		typ := r.typ()
 		val := FixValue(typ, r.Value())
+		checkArithRange(name.Pos(), typ, val)
 		setType(name, typ)
 		setValue(name, val)
 		return name, nil
@@ -999,9 +1000,20 @@ func Shapify(targ *types.Type, basic bool) *types.Type {
 		under = types.NewPtr(types.Types[types.TUINT8])
 	}
 
+	// A type with //panikint: directives gets a shape of its own, so
+	// that its instantiations are instrumented as the directives say.
+	// That shape carries the directives too, and is its own shape.
+	arith := targ.Arith()
+	uls := under.LinkString()
+	if arith != nil {
+		if targ.IsShape() {
+			return targ
+		}
+		uls = targ.LinkString()
+	}
+
 	// Hash long type names to bound symbol name length seen by users,
 	// particularly for large protobuf structs (#65030).
-	uls := under.LinkString()
 	if base.Debug.MaxShapeLen != 0 &&
 		len(uls) > base.Debug.MaxShapeLen {
 		h := hash.Sum32([]byte(uls))
@@ -1013,6 +1025,9 @@ func Shapify(targ *types.Type, basic bool) *types.Type {
 		name := ir.NewDeclNameAt(under.Pos(), ir.OTYPE, sym)
 		typ := types.NewNamed(name)
 		typ.SetUnderlying(under)
+		if arith != nil {
+			typ.SetArith(arith)
+		}
 		sym.Def = typed(typ, name)
 	}
 	res := sym.Def.Type()
@@ -1281,10 +1296,22 @@ func (r *reader) typeExt(name *ir.Name) {
 	}
 
 	name.SetPragma(r.pragmaFlag())
+	r.typeArith(typ)
 
 	typecheck.SetBaseTypeIndex(typ, r.Int64(), r.Int64())
 }
 
+// typeArith reads the //panikint: directives of the defined type typ.
+func (r *reader) typeArith(typ *types.Type) {
+	if r.Bool() {
+		a := &types.ArithType{Name: typ.NameString(), Wrapping: r.Bool()}
+		if r.Bool() {
+			a.Range, a.Lo, a.Hi = true, r.Uint64(), r.Uint64()
+		}
+		typ.SetArith(a)
+	}
+}
+
 func (r *reader) varExt(name *ir.Name) {
 	r.Sync(pkgbits.SyncVarExt)
 	r.linkname(name)
@@ -1789,17 +1816,22 @@ func (r *reader) stmt1(tag codeStmt, out *ir.Nodes) ir.Node {
 
 	case stmtAssignOp:
 		op := r.op()
+		noCheck := r.Bool()
 		lhs := r.expr()
 		pos := r.pos()
 		rhs := r.expr()
-		return ir.NewAssignOpStmt(pos, op, lhs, rhs)
+		n := ir.NewAssignOpStmt(pos, op, lhs, rhs)
+		n.NoArithCheck = noCheck
+		return n
 
 	case stmtIncDec:
 		op := r.op()
+		noCheck := r.Bool()
 		lhs := r.expr()
 		pos := r.pos()
 		n := ir.NewAssignOpStmt(pos, op, lhs, ir.NewOne(pos, lhs.Type()))
 		n.IncDec = true
+		n.NoArithCheck = noCheck
 		return n
 
 	case stmtBlock:
@@ -2215,6 +2247,11 @@ func (r *reader) expr() (res ir.Node) {
 		pos := r.pos()
 		typ := r.typ()
 		val := FixValue(typ, r.Value())
+		for i, n := 0, r.Len(); i < n; i++ {
+			pos := r.pos()
+			typ := r.typ()
+			checkArithRange(pos, typ, FixValue(typ, r.Value()))
+		}
 		return ir.NewBasicLit(pos, typ, val)
 
 	case exprZero:
@@ -2380,6 +2417,7 @@ func (r *reader) expr() (res ir.Node) {
 
 	case exprUnaryOp:
 		op := r.op()
+		noCheck := r.Bool()
 		pos := r.pos()
 		x := r.expr()
 
@@ -2389,10 +2427,13 @@ func (r *reader) expr() (res ir.Node) {
 		case ir.ODEREF:
 			return typecheck.Expr(ir.NewStarExpr(pos, x))
 		}
-		return typecheck.Expr(ir.NewUnaryExpr(pos, op, x))
+		n := ir.NewUnaryExpr(pos, op, x)
+		n.SetNoArithCheck(noCheck)
+		return typecheck.Expr(n)
 
 	case exprBinaryOp:
 		op := r.op()
+		noCheck := r.Bool()
 		x := r.expr()
 		pos := r.pos()
 		y := r.expr()
@@ -2408,7 +2449,9 @@ func (r *reader) expr() (res ir.Node) {
 				assert(val.Kind() == constant.Int && constant.Sign(val) >= 0)
 			}
 		}
-		return typecheck.Expr(ir.NewBinaryExpr(pos, op, x, y))
+		n := ir.NewBinaryExpr(pos, op, x, y)
+		n.SetNoArithCheck(noCheck)
+		return typecheck.Expr(n)
 
 	case exprRecv:
 		x := r.expr()
@@ -2576,6 +2619,7 @@ func (r *reader) expr() (res ir.Node) {
 
 	case exprConvert:
 		implicit := r.Bool()
+		noCheck := r.Bool()
 		typ := r.typ()
 		pos := r.pos()
 		typeWord, srcRType := r.convRTTI(pos)
@@ -2591,6 +2635,7 @@ func (r *reader) expr() (res ir.Node) {
 		if implicit {
 			ce.SetImplicit(true)
 		}
+		ce.SetNoArithCheck(noCheck)
 		n := typecheck.Expr(ce)
 
 		// Conversions between non-identical, non-empty interfaces always
@@ -330,6 +330,7 @@ func writePkgStub(m posMap, noders []*noder) string {
 	pw := newPkgWriter(m, pkg, info, otherInfo)
 
 	pw.collectDecls(noders)
+	typecheck.Target.ArithDirectives = pw.arithDirectives
 
 	publicRootWriter := pw.newWriter(pkgbits.SectionMeta, pkgbits.SyncPublic)
 	privateRootWriter := pw.newWriter(pkgbits.SectionMeta, pkgbits.SyncPrivate)
@@ -95,6 +95,21 @@ type pkgWriter struct {
 	// cgoPragmas accumulates any //go:cgo_* pragmas that need to be
 	// passed through to cmd/link.
 	cgoPragmas [][]string
+
+	// arithIgnores maps statements and function declarations to the
+	// arithmetic checks suppressed for them by //panikint:ignore
+	// directives, and fileArithIgnores maps file bases to the checks
+	// suppressed for the whole file.
+	arithIgnores     map[syntax.Node]ir.ArithChecks
+	fileArithIgnores map[*syntax.PosBase]ir.ArithChecks
+
+	// arithDirectives lists the //panikint:ignore and
+	// //go:nooverflowcheck directives of the package.
+	arithDirectives []*ir.ArithDirective
+
+	// arithTypes maps the defined types declared with //panikint:
+	// directives to them.
+	arithTypes map[*types2.TypeName]*types.ArithType
 }
 
 // newPkgWriter returns an initialized pkgWriter for the specified
@@ -121,6 +136,10 @@ func newPkgWriter(m posMap, pkg *types2.Package, info *types2.Info, otherInfo ma
 			remote string
 			std    bool
 		}),
+
+		arithIgnores:     make(map[syntax.Node]ir.ArithChecks),
+		fileArithIgnores: make(map[*syntax.PosBase]ir.ArithChecks),
+		arithTypes:       make(map[*types2.TypeName]*types.ArithType),
 	}
 }
 
@@ -199,6 +218,13 @@ type writer struct {
 	// derived tracks whether the type being written out references any
 	// type parameters. It's unused for writing non-type things.
 	derived bool
+
+	// noArithChecks is the set of arithmetic checks suppressed for the
+	// code being written. It's unused for writing out non-body things.
+	noArithChecks ir.ArithChecks
+
+	// operand is set by operandExpr for the expression written next.
+	operand bool
 }
 
 // A writerDict tracks types and objects that are used by a declaration.
@@ -1171,8 +1197,13 @@ func (w *writer) funcExt(obj *types2.Func) {
 		}
 	}
 
+	noChecks := w.p.fileArithIgnores[decl.Pos().FileBase()] | w.p.arithIgnores[decl]
+	if pragma&ir.NoOverflowCheck != 0 {
+		noChecks |= ir.OverflowChecks
+	}
+
 	sig, block := obj.Type().(*types2.Signature), decl.Body
-	body, closureVars := w.p.bodyIdx(sig, block, w.dict)
+	body, closureVars := w.p.bodyIdx(sig, block, w.dict, noChecks)
 	if len(closureVars) > 0 {
 		fmt.Fprintln(os.Stderr, "CLOSURE", closureVars)
 	}
@@ -1209,6 +1240,7 @@ func (w *writer) typeExt(obj *types2.TypeName) {
 	w.Sync(pkgbits.SyncTypeExt)
 
 	w.pragmaFlag(asPragmaFlag(decl.Pragma))
+	w.typeArith(obj)
 
 	// No LSym.SymIdx info yet.
 	w.Int64(-1)
@@ -1228,6 +1260,57 @@ func (w *writer) linkname(obj types2.Object) {
 	w.Bool(info.std)
 }
 
+// constValues writes the values of defined types within the constant
+// expression expr, which are checked against the range declared for
+// these types, if any: those of the explicit conversions and that of
+// expr itself. The constant operands of operators are not values of
+// the range: the results of the operations are. Nor are the names of
+// typed constants, whose declarations are checked.
+func (w *writer) constValues(expr syntax.Expr, operand bool) {
+	var values []syntax.Expr
+	syntax.Inspect(expr, func(n syntax.Node) bool {
+		if call, ok := n.(*syntax.CallExpr); ok && w.p.typeAndValue(call.Fun).IsType() {
+			values = append(values, call)
+		}
+		return true
+	})
+	if !operand && !w.p.isTypedConstName(expr) && !slices.Contains(values, expr) {
+		values = append(values, expr)
+	}
+	values = slices.DeleteFunc(values, func(expr syntax.Expr) bool {
+		tv := w.p.typeAndValue(expr)
+		_, ok := types2.Unalias(tv.Type).(*types2.Named)
+		return !ok || tv.Value == nil
+	})
+	w.Len(len(values))
+	for _, expr := range values {
+		tv := w.p.typeAndValue(expr)
+		w.pos(expr)
+		w.typ(tv.Type)
+		w.Value(tv.Value)
+	}
+}
+
+// isTypedConstName reports whether expr names a constant declared with
+// its type.
+func (pw *pkgWriter) isTypedConstName(expr syntax.Expr) bool {
+	obj, _ := lookupObj(pw, expr)
+	c, ok := obj.(*types2.Const)
+	return ok && types2.Identical(c.Type(), pw.typeOf(expr))
+}
+
+// typeArith writes the //panikint: directives of the defined type obj.
+func (w *writer) typeArith(obj *types2.TypeName) {
+	a := w.p.arithTypes[obj]
+	if w.Bool(a != nil) {
+		w.Bool(a.Wrapping)
+		if w.Bool(a.Range) {
+			w.Uint64(a.Lo)
+			w.Uint64(a.Hi)
+		}
+	}
+}
+
 func (w *writer) pragmaFlag(p ir.PragmaFlag) {
 	w.Sync(pkgbits.SyncPragma)
 	w.Int(int(p))
@@ -1236,11 +1319,13 @@ func (w *writer) pragmaFlag(p ir.PragmaFlag) {
 // @@@ Function bodies
 
 // bodyIdx returns the index for the given function body (specified by
-// block), adding it to the export data
-func (pw *pkgWriter) bodyIdx(sig *types2.Signature, block *syntax.BlockStmt, dict *writerDict) (idx index, closureVars []posVar) {
+// block), adding it to the export data. The arithmetic checks in
+// noChecks are suppressed throughout the body.
+func (pw *pkgWriter) bodyIdx(sig *types2.Signature, block *syntax.BlockStmt, dict *writerDict, noChecks ir.ArithChecks) (idx index, closureVars []posVar) {
 	w := pw.newWriter(pkgbits.SectionBody, pkgbits.SyncFuncBody)
 	w.sig = sig
 	w.dict = dict
+	w.noArithChecks = noChecks
 
 	w.declareParams(sig)
 	if w.Bool(block != nil) {
@@ -1355,6 +1440,11 @@ func (w *writer) stmts(stmts []syntax.Stmt) {
 }
 
 func (w *writer) stmt1(stmt syntax.Stmt) {
+	if checks := w.p.arithIgnores[stmt]; checks&^w.noArithChecks != 0 {
+		defer func(old ir.ArithChecks) { w.noArithChecks = old }(w.noArithChecks)
+		w.noArithChecks |= checks
+	}
+
 	switch stmt := stmt.(type) {
 	default:
 		w.p.unexpected("statement", stmt)
@@ -1367,12 +1457,14 @@ func (w *writer) stmt1(stmt syntax.Stmt) {
 		case stmt.Rhs == nil:
 			w.Code(stmtIncDec)
 			w.op(binOps[stmt.Op])
+			w.noArithCheck(ir.OverflowChecks)
 			w.expr(stmt.Lhs)
 			w.pos(stmt)
 
 		case stmt.Op != 0 && stmt.Op != syntax.Def:
 			w.Code(stmtAssignOp)
 			w.op(binOps[stmt.Op])
+			w.noArithCheck(ir.OverflowChecks)
 			w.expr(stmt.Lhs)
 			w.pos(stmt)
 
@@ -1380,7 +1472,7 @@ func (w *writer) stmt1(stmt syntax.Stmt) {
 			if stmt.Op != syntax.Shl && stmt.Op != syntax.Shr {
 				typ = w.p.typeOf(stmt.Lhs)
 			}
-			w.implicitConvExpr(typ, stmt.Rhs)
+			w.operandExpr(typ, stmt.Rhs)
 
 		default:
 			w.assignStmt(stmt, stmt.Lhs, stmt.Rhs)
@@ -1819,7 +1911,8 @@ func (w *writer) switchStmt(stmt *syntax.SwitchStmt) {
 				if tagTypeIsChan {
 					typ = nil
 				}
-				w.implicitConvExpr(typ, cas)
+				// The cases are compared with the tag.
+				w.operandExpr(typ, cas)
 			}
 		}
 
@@ -1870,6 +1963,8 @@ func (w *writer) expr(expr syntax.Expr) {
 	base.Assertf(expr != nil, "missing expression")
 
 	expr = syntax.Unparen(expr) // skip parens; unneeded after typecheck
+	operand := w.operand
+	w.operand = false
 
 	obj, inst := lookupObj(w.p, expr)
 	targs := asTypeSlice(inst.TypeArgs)
@@ -1895,6 +1990,7 @@ func (w *writer) expr(expr syntax.Expr) {
 			assert(typ != nil)
 			w.typ(typ)
 			w.Value(tv.Value)
+			w.constValues(expr, operand)
 			return
 		}
 
@@ -2029,6 +2125,7 @@ func (w *writer) expr(expr syntax.Expr) {
 		if expr.Y == nil {
 			w.Code(exprUnaryOp)
 			w.op(unOps[expr.Op])
+			w.noArithCheck(ir.OverflowChecks)
 			w.pos(expr)
 			w.expr(expr.X)
 			break
@@ -2053,9 +2150,10 @@ func (w *writer) expr(expr syntax.Expr) {
 
 		w.Code(exprBinaryOp)
 		w.op(binOps[expr.Op])
-		w.implicitConvExpr(commonType, expr.X)
+		w.noArithCheck(ir.OverflowChecks)
+		w.operandExpr(commonType, expr.X)
 		w.pos(expr)
-		w.implicitConvExpr(commonType, expr.Y)
+		w.operandExpr(commonType, expr.Y)
 
 	case *syntax.CallExpr:
 		tv := w.p.typeAndValue(expr.Fun)
@@ -2422,6 +2520,12 @@ func (w *writer) multiExpr(pos poser, dstType func(int) types2.Type, exprs []syn
 	}
 }
 
+// noArithCheck writes whether the arithmetic checks in checks are
+// suppressed for the operation being written.
+func (w *writer) noArithCheck(checks ir.ArithChecks) {
+	w.Bool(w.noArithChecks&checks != 0)
+}
+
 // implicitConvExpr is like expr, but if dst is non-nil and different
 // from expr's type, then an implicit conversion operation is inserted
 // at expr's position.
@@ -2429,6 +2533,14 @@ func (w *writer) implicitConvExpr(dst types2.Type, expr syntax.Expr) {
 	w.convertExpr(dst, expr, true)
 }
 
+// operandExpr is like implicitConvExpr, but for an operand of an
+// operator, whose constant value is not checked against the range
+// declared for its type.
+func (w *writer) operandExpr(dst types2.Type, expr syntax.Expr) {
+	w.operand = true
+	w.implicitConvExpr(dst, expr)
+}
+
 func (w *writer) convertExpr(dst types2.Type, expr syntax.Expr, implicit bool) {
 	src := w.p.typeOf(expr)
 
@@ -2445,6 +2557,7 @@ func (w *writer) convertExpr(dst types2.Type, expr syntax.Expr, implicit bool) {
 
 	w.Code(exprConvert)
 	w.Bool(implicit)
+	w.noArithCheck(ir.TruncationChecks)
 	w.typ(dst)
 	w.pos(expr)
 	w.convRTTI(src, dst)
@@ -2597,7 +2710,7 @@ func (w *writer) structElems(typ *types2.Struct, valuesOnly bool, elems []syntax
 func (w *writer) funcLit(expr *syntax.FuncLit) {
 	sig := w.p.typeOf(expr).(*types2.Signature)
 
-	body, closureVars := w.p.bodyIdx(sig, expr.Body, w.dict)
+	body, closureVars := w.p.bodyIdx(sig, expr.Body, w.dict, w.noArithChecks)
 
 	w.Sync(pkgbits.SyncFuncLit)
 	w.pos(expr)
@@ -2807,10 +2920,10 @@ func (c *declCollector) Visit(n syntax.Node) syntax.Visitor {
 
 	switch n := n.(type) {
 	case *syntax.File:
-		pw.checkPragmas(n.Pragma, ir.GoBuildPragma, false)
+		pw.checkPragmas(n.Pragma, ir.GoBuildPragma, false, false)
 
 	case *syntax.ImportDecl:
-		pw.checkPragmas(n.Pragma, 0, false)
+		pw.checkPragmas(n.Pragma, 0, false, false)
 
 		switch pw.info.PkgNameOf(n).Imported().Path() {
 		case "embed":
@@ -2820,10 +2933,10 @@ func (c *declCollector) Visit(n syntax.Node) syntax.Visitor {
 		}
 
 	case *syntax.ConstDecl:
-		pw.checkPragmas(n.Pragma, 0, false)
+		pw.checkPragmas(n.Pragma, 0, false, false)
 
 	case *syntax.FuncDecl:
-		pw.checkPragmas(n.Pragma, funcPragmas, false)
+		pw.checkPragmas(n.Pragma, funcPragmas, false, false)
 
 		obj := pw.info.Defs[n.Name].(*types2.Func)
 		pw.funDecls[obj] = n
@@ -2835,9 +2948,10 @@ func (c *declCollector) Visit(n syntax.Node) syntax.Visitor {
 		d := typeDeclGen{TypeDecl: n, implicits: c.implicits}
 
 		if n.Alias {
-			pw.checkPragmas(n.Pragma, 0, false)
+			pw.checkPragmas(n.Pragma, 0, false, false)
 		} else {
-			pw.checkPragmas(n.Pragma, 0, false)
+			pw.checkPragmas(n.Pragma, 0, false, true)
+			pw.checkTypeArith(obj, n.Pragma)
 
 			// Assign a unique ID to function-scoped defined types.
 			if c.withinFunc {
@@ -2855,7 +2969,7 @@ func (c *declCollector) Visit(n syntax.Node) syntax.Visitor {
 		return c.withTParams(obj)
 
 	case *syntax.VarDecl:
-		pw.checkPragmas(n.Pragma, 0, true)
+		pw.checkPragmas(n.Pragma, 0, true, false)
 
 		if p, ok := n.Pragma.(*pragmas); ok && len(p.Embeds) > 0 {
 			if err := checkEmbed(n, c.file.importedEmbed, c.withinFunc); err != nil {
@@ -2918,10 +3032,88 @@ func (pw *pkgWriter) collectDecls(noders []*noder) {
 				}
 			}
 		}
+
+		pw.collectArithIgnores(p)
+	}
+}
+
+// collectArithIgnores resolves the //panikint:ignore directives in p's
+// file. A directive before the package clause applies to the whole
+// file. Otherwise, it applies to the statements and function
+// declarations that begin on its line, or on the next line if the
+// directive is on a line by itself. It also records these directives
+// and the //go:nooverflowcheck ones for the report of stale directives.
+func (pw *pkgWriter) collectArithIgnores(p *noder) {
+	newDirective := func(pos syntax.Pos, name string, checks ir.ArithChecks) *ir.ArithDirective {
+		d := &ir.ArithDirective{Pos: pw.m.makeXPos(pos), Name: name, Checks: checks, Generic: true}
+		pw.arithDirectives = append(pw.arithDirectives, d)
+		return d
+	}
+	addRange := func(d *ir.ArithDirective, n syntax.Node, generic bool) {
+		d.Ranges = append(d.Ranges, ir.ArithRange{
+			Start: base.Ctxt.PosTable.Pos(pw.m.makeXPos(syntax.StartPos(n))),
+			End:   base.Ctxt.PosTable.Pos(pw.m.makeXPos(syntax.EndPos(n))),
+		})
+		d.Generic = d.Generic && generic
+	}
+
+	pkgLine := p.file.Pos().Line()
+	lines := make(map[uint]ir.ArithChecks)
+	directives := make(map[uint][]*ir.ArithDirective)
+	ignores := make([]*ir.ArithDirective, len(p.arithIgnores))
+	for i, ig := range p.arithIgnores {
+		d := newDirective(ig.pos, "panikint:ignore", ig.checks)
+		ignores[i] = d
+		if ig.pos.Line() < pkgLine {
+			pw.fileArithIgnores[p.file.Pos().FileBase()] |= ig.checks
+			d.File, d.Generic = true, false
+			continue
+		}
+		lines[ig.line] |= ig.checks
+		directives[ig.line] = append(directives[ig.line], d)
+	}
+
+	for _, decl := range p.file.DeclList {
+		generic := false
+		if decl, ok := decl.(*syntax.FuncDecl); ok {
+			if obj, ok := pw.info.Defs[decl.Name].(*types2.Func); ok {
+				sig := obj.Type().(*types2.Signature)
+				generic = sig.RecvTypeParams() != nil || sig.TypeParams() != nil
+			}
+			if pragma, ok := decl.Pragma.(*pragmas); ok {
+				for _, pos := range pragma.Pos {
+					if pos.Flag&ir.NoOverflowCheck != 0 {
+						addRange(newDirective(pos.Pos, "go:nooverflowcheck", ir.OverflowChecks), decl, generic)
+					}
+				}
+			}
+		}
+		if len(lines) == 0 {
+			continue
+		}
+		syntax.Inspect(decl, func(n syntax.Node) bool {
+			switch n.(type) {
+			case syntax.Stmt, *syntax.FuncDecl:
+				line := syntax.StartPos(n).Line()
+				if checks, ok := lines[line]; ok {
+					pw.arithIgnores[n] |= checks
+					for _, d := range directives[line] {
+						addRange(d, n, generic)
+					}
+				}
+			}
+			return true
+		})
+	}
+
+	for i, ig := range p.arithIgnores {
+		if d := ignores[i]; !d.File && len(d.Ranges) == 0 {
+			pw.errorf(ig.pos, "misplaced //panikint:ignore directive")
+		}
 	}
 }
 
-func (pw *pkgWriter) checkPragmas(p syntax.Pragma, allowed ir.PragmaFlag, embedOK bool) {
+func (pw *pkgWriter) checkPragmas(p syntax.Pragma, allowed ir.PragmaFlag, embedOK, arithOK bool) {
 	if p == nil {
 		return
 	}
@@ -2938,6 +3130,101 @@ func (pw *pkgWriter) checkPragmas(p syntax.Pragma, allowed ir.PragmaFlag, embedO
 			pw.errorf(e.Pos, "misplaced go:embed directive")
 		}
 	}
+
+	if !arithOK {
+		for _, a := range pragma.Arith {
+			pw.errorf(a.Pos, "misplaced //%s directive", a.Verb)
+		}
+	}
+}
+
+// checkTypeArith checks the //panikint: directives of the declaration
+// of the defined type obj, which must be an integer type, and records
+// them in pw.arithTypes.
+func (pw *pkgWriter) checkTypeArith(obj *types2.TypeName, p syntax.Pragma) {
+	pragma, ok := p.(*pragmas)
+	if !ok || len(pragma.Arith) == 0 {
+		return
+	}
+	basic, ok := obj.Type().Underlying().(*types2.Basic)
+	if !ok || basic.Info()&types2.IsInteger == 0 {
+		pw.errorf(pragma.Arith[0].Pos, "//%s directive on non-integer type %s", pragma.Arith[0].Verb, obj.Name())
+		return
+	}
+	a := new(types.ArithType)
+	seen := make(map[string]bool)
+	for _, d := range pragma.Arith {
+		if seen[d.Verb] {
+			pw.errorf(d.Pos, "repeated //%s directive", d.Verb)
+			continue
+		}
+		seen[d.Verb] = true
+		switch d.Verb {
+		case "panikint:wrapping":
+			a.Wrapping = true
+		case "panikint:range":
+			lo, hi, err := parseArithRange(d.Arg, basic)
+			if err != nil {
+				pw.errorf(d.Pos, "%v in //%s directive", err, d.Verb)
+				continue
+			}
+			a.Range, a.Lo, a.Hi = true, lo, hi
+		}
+	}
+	pw.arithTypes[obj] = a
+}
+
+// parseArithRange parses the lo..hi argument of a //panikint:range
+// directive on a type whose underlying type is the integer type basic,
+// and returns the bounds sign or zero extended to 64 bits.
+func parseArithRange(arg string, basic *types2.Basic) (lo, hi uint64, err error) {
+	bits := 8 * int64(types.PtrSize)
+	switch basic.Kind() {
+	case types2.Int8, types2.Uint8:
+		bits = 8
+	case types2.Int16, types2.Uint16:
+		bits = 16
+	case types2.Int32, types2.Uint32:
+		bits = 32
+	case types2.Int64, types2.Uint64:
+		bits = 64
+	}
+	signed := basic.Info()&types2.IsUnsigned == 0
+	one := constant.MakeInt64(1)
+	lower, upper := constant.MakeInt64(0), constant.BinaryOp(constant.Shift(one, token.SHL, uint(bits)), token.SUB, one)
+	if signed {
+		upper = constant.BinaryOp(constant.Shift(one, token.SHL, uint(bits-1)), token.SUB, one)
+		lower = constant.BinaryOp(constant.UnaryOp(token.SUB, upper, 0), token.SUB, one)
+	}
+
+	var bounds [2]constant.Value
+	los, his, _ := strings.Cut(arg, "..")
+	for i, s := range [2]string{los, his} {
+		lit, neg := strings.CutPrefix(s, "-")
+		v := constant.MakeFromLiteral(lit, token.INT, 0)
+		if v.Kind() != constant.Int {
+			return 0, 0, fmt.Errorf("invalid bound %q", s)
+		}
+		if neg {
+			v = constant.UnaryOp(token.SUB, v, 0)
+		}
+		if constant.Compare(v, token.LSS, lower) || constant.Compare(v, token.GTR, upper) {
+			return 0, 0, fmt.Errorf("bound %s overflows %s", s, basic.Name())
+		}
+		bounds[i] = v
+	}
+	if constant.Compare(bounds[0], token.GTR, bounds[1]) {
+		return 0, 0, fmt.Errorf("empty range %s", arg)
+	}
+
+	if signed {
+		l, _ := constant.Int64Val(bounds[0])
+		h, _ := constant.Int64Val(bounds[1])
+		return uint64(l), uint64(h), nil
+	}
+	lo, _ = constant.Uint64Val(bounds[0])
+	hi, _ = constant.Uint64Val(bounds[1])
+	return lo, hi, nil
 }
 
 func (w *writer) pkgInit(noders []*noder) {
@@ -2967,6 +3254,7 @@ func (w *writer) pkgInitOrder() {
 		for _, v := range init.Lhs {
 			w.obj(v, nil)
 		}
+		w.noArithChecks = w.p.fileArithIgnores[init.Rhs.Pos().FileBase()]
 		w.expr(init.Rhs)
 	}
 }
@@ -424,6 +424,15 @@ func init() {
 		{name: "SUBQconstborrow", argLength: 1, reg: gp11flags, typ: "(UInt64,Flags)", asm: "SUBQ", aux: "Int32", resultInArg0: true}, // r = arg0-auxint
 		{name: "SBBQconst", argLength: 2, reg: gp1flags1flags, typ: "(UInt64,Flags)", asm: "SBBQ", aux: "Int32", resultInArg0: true},  // r = arg0-(auxint+carry(arg1))
 
+		// The following 2 opcodes are the 32-bit versions of ADDQcarry and SUBQborrow.
+		{name: "ADDLcarry", argLength: 2, reg: gp21flags, typ: "(UInt32,Flags)", asm: "ADDL", commutative: true, resultInArg0: true}, // r = arg0+arg1
+		{name: "SUBLborrow", argLength: 2, reg: gp21flags, typ: "(UInt32,Flags)", asm: "SUBL", resultInArg0: true},                   // r = arg0-arg1
+
+		// Signed multiply returning the low bits of the product in the first result.
+		// The carry and overflow flags are set if the full product does not fit.
+		{name: "MULQflags", argLength: 2, reg: gp21flags, typ: "(Int64,Flags)", asm: "IMULQ", commutative: true, resultInArg0: true}, // r = arg0*arg1
+		{name: "MULLflags", argLength: 2, reg: gp21flags, typ: "(Int32,Flags)", asm: "IMULL", commutative: true, resultInArg0: true}, // r = arg0*arg1
+
 		{name: "MULQU2", argLength: 2, reg: regInfo{inputs: []regMask{ax, gpsp}, outputs: []regMask{dx, ax}}, commutative: true, asm: "MULQ", clobberFlags: true},        // arg0 * arg1, returns (hi, lo)
 		{name: "DIVQU2", argLength: 3, reg: regInfo{inputs: []regMask{dx, ax, gpsp}, outputs: []regMask{ax, dx}}, asm: "DIVQ", clobberFlags: true, hasSideEffects: true}, // arg0:arg1 / arg2 (128-bit divided by 64-bit), returns (q, r)
 
@@ -569,6 +569,7 @@ func init() {
 		{name: "NotGreaterEqualF", argLength: 1, reg: readflags}, // bool, true flags encode floating-point x<y || x is unordered with y, false otherwise.
 		{name: "LessThanNoov", argLength: 1, reg: readflags},     // bool, true flags encode signed x<y but without honoring overflow, false otherwise.
 		{name: "GreaterEqualNoov", argLength: 1, reg: readflags}, // bool, true flags encode signed x>=y but without honoring overflow, false otherwise.
+		{name: "Overflow", argLength: 1, reg: readflags},         // bool, true flags encode signed overflow (V set), false otherwise.
 
 		// medium zeroing
 		// arg0 = address of memory to zero
@@ -820,6 +821,7 @@ func init() {
 		{name: "LEnoov", controls: 1}, // 'LE' but without honoring overflow
 		{name: "GTnoov", controls: 1}, // 'GT' but without honoring overflow
 		{name: "GEnoov", controls: 1}, // 'GE' but without honoring overflow
+		{name: "VS", controls: 1},     // signed overflow (V set)
 
 		// JUMPTABLE implements jump tables.
 		// Aux is the symbol (an *obj.LSym) for the jump table.
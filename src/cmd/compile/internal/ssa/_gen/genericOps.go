@@ -59,6 +59,20 @@ var genericOps = []opData{
 	{name: "Mul32uover", argLength: 2, typ: "(UInt32,Bool)", commutative: true}, // Let x = arg0*arg1 (full 32x32-> 64 unsigned multiply), returns (uint32(x), (uint32(x) != x))
 	{name: "Mul64uover", argLength: 2, typ: "(UInt64,Bool)", commutative: true}, // Let x = arg0*arg1 (full 64x64->128 unsigned multiply), returns (uint64(x), (uint64(x) != x))
 
+	// Checked arithmetic used by integer overflow detection.
+	// Each op returns (arg0 op arg1 truncated to the operand width, whether the exact result did not fit).
+	// The "u" variants treat their arguments as unsigned.
+	{name: "Add32over", argLength: 2, typ: "(Int32,Bool)", commutative: true},
+	{name: "Add64over", argLength: 2, typ: "(Int64,Bool)", commutative: true},
+	{name: "Add32uover", argLength: 2, typ: "(UInt32,Bool)", commutative: true},
+	{name: "Add64uover", argLength: 2, typ: "(UInt64,Bool)", commutative: true},
+	{name: "Sub32over", argLength: 2, typ: "(Int32,Bool)"},
+	{name: "Sub64over", argLength: 2, typ: "(Int64,Bool)"},
+	{name: "Sub32uover", argLength: 2, typ: "(UInt32,Bool)"},
+	{name: "Sub64uover", argLength: 2, typ: "(UInt64,Bool)"},
+	{name: "Mul32over", argLength: 2, typ: "(Int32,Bool)", commutative: true},
+	{name: "Mul64over", argLength: 2, typ: "(Int64,Bool)", commutative: true},
+
 	// Weird special instructions for use in the strength reduction of divides.
 	// These ops compute unsigned (arg0 + arg1) / 2, correct to all
 	// 32/64 bits, even when the intermediate result of the add has 33/65 bits.
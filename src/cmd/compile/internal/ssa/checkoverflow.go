@@ -0,0 +1,399 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ssa
+
+import (
+	"cmd/compile/internal/logopt"
+	"cmd/compile/internal/types"
+	"cmd/internal/obj"
+	"cmd/internal/src"
+	"fmt"
+	rtabi "internal/abi"
+	"math"
+	"math/big"
+	"strconv"
+)
+
+// An ArithCheckStatus says what became of an integer check
+// considered by go-panikint.
+type ArithCheckStatus uint8
+
+const (
+	ArithCheckInserted   ArithCheckStatus = iota // the check was inserted
+	ArithCheckSuppressed                         // by a //panikint:ignore or //go:nooverflowcheck directive
+	ArithCheckExcluded                           // by -panikint.include and -panikint.exclude or their defaults
+)
+
+// An ArithCheck is an integer check considered by go-panikint.
+type ArithCheck struct {
+	Pos      src.XPos
+	Status   ArithCheckStatus
+	Class    string      // check class, as named by the -panikint flag of the go command
+	Op       string      // operation, such as "addition" or "conversion"
+	Src, Dst *types.Type // operand and result types
+	Fn       *obj.LSym   // panic or report function called by an inserted check
+	Code     int64       // operation code passed to that call, see internal/abi.ArithEncode
+	CodeArg  int         // index of Code in the arguments of Fn, which come after the operands
+
+	// Failure is set by the check overflow pass for an inserted check
+	// whose operands the optimizer proved to be constants that fail it.
+	// It describes the check and the runtime error it reports.
+	Failure string
+}
+
+// String describes c, as in "overflow check on int8 addition"
+// or "truncation check on int64 to int32 conversion".
+func (c *ArithCheck) String() string {
+	if c.Src != c.Dst {
+		return fmt.Sprintf("%s check on %s to %s %s", c.Class, ArithTypeName(c.Src), ArithTypeName(c.Dst), c.Op)
+	}
+	return fmt.Sprintf("%s check on %s %s", c.Class, ArithTypeName(c.Src), c.Op)
+}
+
+// checkoverflow finds the integer checks considered by go-panikint that
+// always fail, because the optimizer turned the operands passed to their
+// panic or report function into constants, and records them in their
+// Failure field. With the corresponding debug options, it also prints all the
+// checks in the function: those that are present, those removed by the
+// optimizer and those that were never inserted because they were
+// suppressed or excluded. Inserted checks are found by the call to their
+// panic or report function.
+func checkoverflow(f *Func) {
+	if len(f.ArithChecks) == 0 {
+		return
+	}
+
+	type call struct {
+		pos src.XPos
+		fn  *obj.LSym
+	}
+	calls := make(map[call][]*Value)
+	for _, b := range f.Blocks {
+		if b.Kind == BlockInvalid {
+			continue
+		}
+		for _, v := range b.Values {
+			if v.Op == OpStaticCall || v.Op == OpStaticLECall {
+				k := call{v.Pos.WithNotStmt(), v.Aux.(*AuxCall).Fn}
+				calls[k] = append(calls[k], v)
+			}
+		}
+	}
+
+	for i := range f.ArithChecks {
+		c := &f.ArithChecks[i]
+		var verb, what string
+		switch c.Status {
+		case ArithCheckInserted:
+			if vs := calls[call{c.Pos.WithNotStmt(), c.Fn}]; vs != nil {
+				verb, what = "Found", "arithCheck"
+				c.Failure = c.failure(vs)
+			} else {
+				verb, what = "Removed", "arithCheckRemoved"
+			}
+		case ArithCheckSuppressed:
+			verb, what = "Suppressed", "arithCheckSuppressed"
+		case ArithCheckExcluded:
+			verb, what = "Excluded", "arithCheckExcluded"
+		}
+		if f.pass.debug > 0 {
+			if c.Failure != "" {
+				f.Warnl(c.Pos, "%s %v, which always fails", verb, c)
+			} else {
+				f.Warnl(c.Pos, "%s %v", verb, c)
+			}
+		}
+		if logopt.Enabled() {
+			logopt.LogOpt(c.Pos, what, "checkoverflow", f.Name, c.String())
+		}
+	}
+}
+
+// failure returns the Failure of the inserted check c, whose calls are
+// among vs, or "" if none of them has constant operands that fail c.
+// The blocks of the check are fused and renumbered by the passes after
+// ssagen, so the branch to a call does not tell whether c fails. Nor do
+// constant operands alone: the prove pass replaces an operand with the
+// constant a branch compares it with, even when the check it guards
+// cannot fail, as in the negation of an unsigned x known to be 0.
+func (c *ArithCheck) failure(vs []*Value) string {
+	// Several checks at the same position may call the same function,
+	// such as those of the index and the pointer of a range loop, or
+	// those of the int32 class. Keep the calls that pass the code of c.
+	var calls [][]*Value
+	for _, v := range vs {
+		args := callArgs(v)
+		if len(args) > c.CodeArg {
+			if code, ok := constArg(args[c.CodeArg]); ok && int64(code) != c.Code {
+				continue
+			}
+			args = args[:c.CodeArg]
+		}
+		calls = append(calls, args)
+	}
+	for _, args := range calls {
+		if len(args) != c.CodeArg {
+			continue
+		}
+		x, ok := constArg(args[0])
+		var y uint64
+		if ok && len(args) == 2 {
+			y, ok = constArg(args[1])
+		}
+		if ok && c.fails(x, y) {
+			return fmt.Sprintf("%s check always fails: %s", c.Class, c.message(x, y))
+		}
+	}
+	return ""
+}
+
+// fails reports whether the check c fails with the operands x and y,
+// as passed to its panic or report function.
+func (c *ArithCheck) fails(x, y uint64) bool {
+	op, _, _, _ := rtabi.ArithDecode(int(c.Code))
+	bits := uint(8 * c.Dst.Size())
+	if op&rtabi.ArithInt32 != 0 {
+		bits = 32
+	}
+	op &^= rtabi.ArithInt32
+
+	// lo and hi are the bounds of the result type, or of its declared
+	// range for a range check.
+	lo, hi := new(big.Int), new(big.Int).Lsh(big.NewInt(1), bits)
+	if c.Dst.IsSigned() {
+		hi.Rsh(hi, 1)
+		lo.Neg(hi)
+	}
+	hi.Sub(hi, big.NewInt(1))
+	if c.Class == "range" {
+		a := c.Dst.Arith()
+		if op != rtabi.ArithConv {
+			// x is the result of the operation.
+			return !a.InRange(c.Dst, x)
+		}
+		if c.Dst.IsSigned() {
+			lo.SetInt64(int64(a.Lo))
+			hi.SetInt64(int64(a.Hi))
+		} else {
+			lo.SetUint64(a.Lo)
+			hi.SetUint64(a.Hi)
+		}
+	}
+
+	// r is the exact result of the operation.
+	var r *big.Int
+	if c.Src.IsFloat() {
+		f := math.Trunc(math.Float64frombits(x))
+		if math.IsNaN(f) || math.IsInf(f, 0) {
+			return true
+		}
+		r, _ = big.NewFloat(f).Int(nil)
+	} else {
+		value := func(v uint64) *big.Int {
+			if c.Src.IsSigned() {
+				return big.NewInt(int64(v))
+			}
+			return new(big.Int).SetUint64(v)
+		}
+		r = value(x)
+		switch op {
+		case rtabi.ArithAdd:
+			r.Add(r, value(y))
+		case rtabi.ArithSub:
+			r.Sub(r, value(y))
+		case rtabi.ArithMul:
+			r.Mul(r, value(y))
+		case rtabi.ArithNeg:
+			r.Neg(r)
+		case rtabi.ArithDiv:
+			if y == 0 {
+				return false
+			}
+			r.Quo(r, value(y))
+		case rtabi.ArithShl:
+			if y >= 128 {
+				return x != 0
+			}
+			r.Lsh(r, uint(y))
+		}
+	}
+	return r.Cmp(lo) < 0 || r.Cmp(hi) > 0
+}
+
+// callArgs returns the arguments of the call v, or nil if they
+// cannot be found. The arguments passed in several registers, such
+// as strings, are left nil.
+func callArgs(v *Value) []*Value {
+	if v.Op == OpStaticLECall {
+		return v.Args[:len(v.Args)-1]
+	}
+	aux := v.Aux.(*AuxCall)
+	args := make([]*Value, aux.NArgs())
+	r := 0
+	for i := range args {
+		switch n := len(aux.RegsOfArg(int64(i))); n {
+		case 0:
+			// Find the store of the argument to the stack.
+			off := aux.OffsetOfArg(int64(i))
+			for m := v.MemoryArg(); m != nil && m.Op == OpStore; m = m.Args[2] {
+				if p := m.Args[0]; p.Op == OpOffPtr && p.AuxInt == off && p.Args[0].Op == OpSP {
+					args[i] = m.Args[1]
+					break
+				}
+			}
+		case 1:
+			args[i] = v.Args[r]
+			r++
+		default:
+			r += n
+			continue
+		}
+		if args[i] == nil {
+			return nil
+		}
+	}
+	return args
+}
+
+// constArg returns the bits of the constant argument v.
+func constArg(v *Value) (uint64, bool) {
+	switch v.Op {
+	case OpConst64, OpConst32, OpConst64F:
+		return uint64(v.AuxInt), true
+	}
+	return 0, false
+}
+
+// ArithTypeName returns the name of type t in the messages of
+// runtime.ArithmeticError: that of t if it has //panikint: directives,
+// or that of its kind. Either way, the shapes of generic code are named
+// as the types they stand for.
+func ArithTypeName(t *types.Type) string {
+	if a := t.Arith(); a != nil {
+		return a.Name
+	}
+	return types.Types[t.Kind()].String()
+}
+
+// arithOpSymbols are the operators of the operations in the
+// messages of runtime.ArithmeticError.
+var arithOpSymbols = [...]string{
+	rtabi.ArithAdd: " + ",
+	rtabi.ArithSub: " - ",
+	rtabi.ArithMul: " * ",
+	rtabi.ArithDiv: " / ",
+	rtabi.ArithShl: " << ",
+}
+
+// message returns the message of the runtime.ArithmeticError reported
+// when the check c fails with the operands x and y, without its
+// "runtime error: " prefix.
+func (c *ArithCheck) message(x, y uint64) string {
+	op, _, _, _ := rtabi.ArithDecode(int(c.Code))
+	bits32 := op&rtabi.ArithInt32 != 0
+	op &^= rtabi.ArithInt32
+	src, dst := ArithTypeName(c.Src), ArithTypeName(c.Dst)
+	if bits32 {
+		dst = "32-bit " + dst
+	}
+	if c.Class == "range" {
+		// x is the value of the result, outside the declared range,
+		// or the operand of the conversion.
+		r := c.Dst.Arith().RangeString(c.Dst)
+		if op == rtabi.ArithConv {
+			var value string
+			switch {
+			case c.Src.IsFloat():
+				value = strconv.FormatFloat(math.Float64frombits(x), 'g', -1, int(c.Src.Size()*8))
+			case c.Src.IsSigned():
+				value = strconv.FormatInt(int64(x), 10)
+			default:
+				value = strconv.FormatUint(x, 10)
+			}
+			return fmt.Sprintf("%s(%s) out of range %s", dst, value, r)
+		}
+		value := strconv.FormatUint(x, 10)
+		if c.Dst.IsSigned() {
+			value = strconv.FormatInt(int64(x), 10)
+		}
+		return fmt.Sprintf("%s %s result %s out of range %s", dst, c.Op, value, r)
+	}
+	if c.Src.IsFloat() {
+		return fmt.Sprintf("%s(%s) cannot fit in %s", src, strconv.FormatFloat(math.Float64frombits(x), 'g', -1, int(c.Src.Size()*8)), dst)
+	}
+
+	// wrap truncates v to the width of t and extends it back to 64 bits.
+	wrap := func(v uint64, t *types.Type, bits32 bool) uint64 {
+		shift := 64 - 8*t.Size()
+		if bits32 && (t.Kind() == types.TINT || t.Kind() == types.TUINT) {
+			shift = 32
+		}
+		if t.IsSigned() {
+			return uint64(int64(v<<shift) >> shift)
+		}
+		return v << shift >> shift
+	}
+	str := func(v uint64, signed bool) string {
+		if signed {
+			return strconv.FormatInt(int64(v), 10)
+		}
+		return strconv.FormatUint(v, 10)
+	}
+	signed := c.Src.IsSigned()
+	neg := signed && int64(x) < 0
+
+	var r uint64
+	switch op {
+	case rtabi.ArithAdd:
+		r = x + y
+	case rtabi.ArithSub:
+		r = x - y
+	case rtabi.ArithMul:
+		r = x * y
+	case rtabi.ArithNeg:
+		r = -x
+	case rtabi.ArithShl:
+		r = x << y
+	case rtabi.ArithDiv:
+		switch {
+		case y == 0:
+		case signed:
+			r = uint64(int64(x) / int64(y))
+		default:
+			r = x / y
+		}
+	default:
+		r = x
+	}
+	r = wrap(r, c.Dst, bits32)
+	result := str(r, c.Dst.IsSigned())
+
+	if op == rtabi.ArithConv {
+		if wrap(r, c.Src, false) == x {
+			return fmt.Sprintf("%s(%s) changes sign in %s (converted to %s)", src, str(x, signed), dst, result)
+		}
+		return fmt.Sprintf("%s(%s) cannot fit in %s (truncated to %s)", src, str(x, signed), dst, result)
+	}
+	var under bool
+	switch op {
+	case rtabi.ArithAdd, rtabi.ArithShl:
+		under = neg
+	case rtabi.ArithSub:
+		under = neg || !signed
+	case rtabi.ArithMul:
+		under = neg != (signed && int64(y) < 0)
+	case rtabi.ArithNeg:
+		under = !signed
+	}
+	kind := "overflow"
+	if under {
+		kind = "underflow"
+	}
+	operands := str(x, signed) + arithOpSymbols[op] + str(y, signed && op != rtabi.ArithShl)
+	if op == rtabi.ArithNeg {
+		operands = "-(" + str(x, signed) + ")"
+	}
+	return fmt.Sprintf("%s %s %s: %s (wrapped to %s)", dst, c.Op, kind, operands, result)
+}
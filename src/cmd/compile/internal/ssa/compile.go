@@ -484,6 +484,7 @@ var passes = [...]pass{
 	{name: "generic deadcode", fn: deadcode, required: true}, // remove dead stores, which otherwise mess up store chain
 	{name: "late fuse", fn: fuseLate},
 	{name: "check bce", fn: checkbce},
+	{name: "check overflow", fn: checkoverflow, required: true},
 	{name: "dse", fn: dse},
 	{name: "memcombine", fn: memcombine},
 	{name: "writebarrier", fn: writebarrier, required: true}, // expand write barrier ops
@@ -559,6 +560,8 @@ var passOrder = [...]constraint{
 	{"generic cse", "tighten"},
 	// checkbce needs the values removed
 	{"generic deadcode", "check bce"},
+	// checkoverflow needs the values removed
+	{"generic deadcode", "check overflow"},
 	// decompose builtin now also cleans up after expand calls
 	{"expand calls", "decompose builtin"},
 	// don't run optimization pass until we've decomposed builtin objects
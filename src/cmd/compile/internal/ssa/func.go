@@ -50,6 +50,10 @@ type Func struct {
 	IsPgoHot    bool
 	DeferReturn *Block // avoid creating more than one deferreturn if there's multiple calls to deferproc-etc.
 
+	// ArithChecks lists the integer checks considered by go-panikint,
+	// in source order, for the check overflow pass.
+	ArithChecks []ArithCheck
+
 	// when register allocation is done, maps value ids to locations
 	RegAlloc []Location
 
@@ -40,26 +40,29 @@ type indVar struct {
 //   - the header's edge returning from the body
 //
 // Currently, we detect induction variables that match (Phi min nxt),
-// with nxt being (Add inc ind).
+// with nxt being (Add inc ind), or (Select0 (AddNNover inc ind)) when
+// the increment is checked for overflow.
 // If it can't parse the induction variable correctly, it returns (nil, nil, nil).
 func parseIndVar(ind *Value) (min, inc, nxt *Value, loopReturn Edge) {
 	if ind.Op != OpPhi {
 		return
 	}
 
-	if n := ind.Args[0]; (n.Op == OpAdd64 || n.Op == OpAdd32 || n.Op == OpAdd16 || n.Op == OpAdd8) && (n.Args[0] == ind || n.Args[1] == ind) {
+	var x, y *Value
+	if n := ind.Args[0]; isIndVarAdd(n, ind) {
 		min, nxt, loopReturn = ind.Args[1], n, ind.Block.Preds[0]
-	} else if n := ind.Args[1]; (n.Op == OpAdd64 || n.Op == OpAdd32 || n.Op == OpAdd16 || n.Op == OpAdd8) && (n.Args[0] == ind || n.Args[1] == ind) {
+	} else if n := ind.Args[1]; isIndVarAdd(n, ind) {
 		min, nxt, loopReturn = ind.Args[0], n, ind.Block.Preds[1]
 	} else {
 		// Not a recognized induction variable.
 		return
 	}
 
-	if nxt.Args[0] == ind { // nxt = ind + inc
-		inc = nxt.Args[1]
-	} else if nxt.Args[1] == ind { // nxt = inc + ind
-		inc = nxt.Args[0]
+	x, y = indVarAddArgs(nxt)
+	if x == ind { // nxt = ind + inc
+		inc = y
+	} else if y == ind { // nxt = inc + ind
+		inc = x
 	} else {
 		panic("unreachable") // one of the cases must be true from the above.
 	}
@@ -67,6 +70,48 @@ func parseIndVar(ind *Value) (min, inc, nxt *Value, loopReturn Edge) {
 	return
 }
 
+// indVarAddArgs returns the operands of n if n is an addition that can
+// increment an induction variable, or nil, nil otherwise.
+func indVarAddArgs(n *Value) (x, y *Value) {
+	switch n.Op {
+	case OpAdd64, OpAdd32, OpAdd16, OpAdd8:
+		return n.Args[0], n.Args[1]
+	case OpSelect0:
+		if t := n.Args[0]; t.Op == OpAdd64over || t.Op == OpAdd32over {
+			return t.Args[0], t.Args[1]
+		}
+	}
+	return nil, nil
+}
+
+// isIndVarAdd reports whether n is an addition with ind as one of its operands.
+func isIndVarAdd(n, ind *Value) bool {
+	x, y := indVarAddArgs(n)
+	return x != nil && (x == ind || y == ind)
+}
+
+// uncheckIndVarInc replaces the overflow-checked increment nxt of an
+// induction variable, which findIndVar proved cannot wrap, with a plain
+// addition, and sets the overflow bit of the checked op to false.
+func uncheckIndVarInc(f *Func, nxt *Value) {
+	if nxt.Op != OpSelect0 {
+		return
+	}
+	t := nxt.Args[0]
+	checked, op := t.Op, OpAdd64
+	if checked == OpAdd32over {
+		op = OpAdd32
+	}
+	x, y := t.Args[0], t.Args[1]
+	nxt.reset(op)
+	nxt.AddArg2(x, y)
+	t.reset(OpMakeTuple)
+	t.AddArg2(nxt, f.ConstBool(f.Config.Types.Bool, false))
+	if f.pass.debug >= 1 {
+		f.Warnl(nxt.Pos, "Proved %v does not overflow", checked)
+	}
+}
+
 // findIndVar finds induction variables in a function.
 //
 // Look for variables and blocks that satisfy the following
@@ -336,6 +381,7 @@ nextblock:
 				if f.pass.debug >= 1 {
 					printIndVar(b, ind, min, max, step, flags)
 				}
+				uncheckIndVarInc(f, nxt)
 
 				iv = append(iv, indVar{
 					ind: ind,
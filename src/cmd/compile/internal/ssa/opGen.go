@@ -92,6 +92,7 @@ const (
 	BlockARM64LEnoov
 	BlockARM64GTnoov
 	BlockARM64GEnoov
+	BlockARM64VS
 	BlockARM64JUMPTABLE
 
 	BlockLOONG64EQZ
@@ -249,6 +250,7 @@ var blockString = [...]string{
 	BlockARM64LEnoov:    "LEnoov",
 	BlockARM64GTnoov:    "GTnoov",
 	BlockARM64GEnoov:    "GEnoov",
+	BlockARM64VS:        "VS",
 	BlockARM64JUMPTABLE: "JUMPTABLE",
 
 	BlockLOONG64EQZ:       "EQZ",
@@ -673,6 +675,10 @@ const (
 	OpAMD64SBBQ
 	OpAMD64SUBQconstborrow
 	OpAMD64SBBQconst
+	OpAMD64ADDLcarry
+	OpAMD64SUBLborrow
+	OpAMD64MULQflags
+	OpAMD64MULLflags
 	OpAMD64MULQU2
 	OpAMD64DIVQU2
 	OpAMD64ANDQ
@@ -4446,6 +4452,7 @@ const (
 	OpARM64NotGreaterEqualF
 	OpARM64LessThanNoov
 	OpARM64GreaterEqualNoov
+	OpARM64Overflow
 	OpARM64LoweredZero
 	OpARM64LoweredZeroLoop
 	OpARM64LoweredMove
@@ -5775,6 +5782,16 @@ const (
 	OpMul64uhilo
 	OpMul32uover
 	OpMul64uover
+	OpAdd32over
+	OpAdd64over
+	OpAdd32uover
+	OpAdd64uover
+	OpSub32over
+	OpSub64over
+	OpSub32uover
+	OpSub64uover
+	OpMul32over
+	OpMul64over
 	OpAvg32u
 	OpAvg64u
 	OpDiv8
@@ -12293,6 +12310,73 @@ var opcodeTable = [...]opInfo{
 			},
 		},
 	},
+	{
+		name:         "ADDLcarry",
+		argLen:       2,
+		commutative:  true,
+		resultInArg0: true,
+		asm:          x86.AADDL,
+		reg: regInfo{
+			inputs: []inputInfo{
+				{0, regMask{v1: 49135, v2: 0}}, // AX CX DX BX BP SI DI R8 R9 R10 R11 R12 R13 R15
+				{1, regMask{v1: 49135, v2: 0}}, // AX CX DX BX BP SI DI R8 R9 R10 R11 R12 R13 R15
+			},
+			outputs: []outputInfo{
+				{1, regMask{v1: 0, v2: 0}},
+				{0, regMask{v1: 49135, v2: 0}}, // AX CX DX BX BP SI DI R8 R9 R10 R11 R12 R13 R15
+			},
+		},
+	},
+	{
+		name:         "SUBLborrow",
+		argLen:       2,
+		resultInArg0: true,
+		asm:          x86.ASUBL,
+		reg: regInfo{
+			inputs: []inputInfo{
+				{0, regMask{v1: 49135, v2: 0}}, // AX CX DX BX BP SI DI R8 R9 R10 R11 R12 R13 R15
+				{1, regMask{v1: 49135, v2: 0}}, // AX CX DX BX BP SI DI R8 R9 R10 R11 R12 R13 R15
+			},
+			outputs: []outputInfo{
+				{1, regMask{v1: 0, v2: 0}},
+				{0, regMask{v1: 49135, v2: 0}}, // AX CX DX BX BP SI DI R8 R9 R10 R11 R12 R13 R15
+			},
+		},
+	},
+	{
+		name:         "MULQflags",
+		argLen:       2,
+		commutative:  true,
+		resultInArg0: true,
+		asm:          x86.AIMULQ,
+		reg: regInfo{
+			inputs: []inputInfo{
+				{0, regMask{v1: 49135, v2: 0}}, // AX CX DX BX BP SI DI R8 R9 R10 R11 R12 R13 R15
+				{1, regMask{v1: 49135, v2: 0}}, // AX CX DX BX BP SI DI R8 R9 R10 R11 R12 R13 R15
+			},
+			outputs: []outputInfo{
+				{1, regMask{v1: 0, v2: 0}},
+				{0, regMask{v1: 49135, v2: 0}}, // AX CX DX BX BP SI DI R8 R9 R10 R11 R12 R13 R15
+			},
+		},
+	},
+	{
+		name:         "MULLflags",
+		argLen:       2,
+		commutative:  true,
+		resultInArg0: true,
+		asm:          x86.AIMULL,
+		reg: regInfo{
+			inputs: []inputInfo{
+				{0, regMask{v1: 49135, v2: 0}}, // AX CX DX BX BP SI DI R8 R9 R10 R11 R12 R13 R15
+				{1, regMask{v1: 49135, v2: 0}}, // AX CX DX BX BP SI DI R8 R9 R10 R11 R12 R13 R15
+			},
+			outputs: []outputInfo{
+				{1, regMask{v1: 0, v2: 0}},
+				{0, regMask{v1: 49135, v2: 0}}, // AX CX DX BX BP SI DI R8 R9 R10 R11 R12 R13 R15
+			},
+		},
+	},
 	{
 		name:         "MULQU2",
 		argLen:       2,
@@ -69161,6 +69245,15 @@ var opcodeTable = [...]opInfo{
 			},
 		},
 	},
+	{
+		name:   "Overflow",
+		argLen: 1,
+		reg: regInfo{
+			outputs: []outputInfo{
+				{0, regMask{v1: 335544319, v2: 0}}, // R0 R1 R2 R3 R4 R5 R6 R7 R8 R9 R10 R11 R12 R13 R14 R15 R16 R17 R19 R20 R21 R22 R23 R24 R25 R26 R30
+			},
+		},
+	},
 	{
 		name:           "LoweredZero",
 		auxType:        auxInt64,
@@ -87093,6 +87186,62 @@ var opcodeTable = [...]opInfo{
 		commutative: true,
 		generic:     true,
 	},
+	{
+		name:        "Add32over",
+		argLen:      2,
+		commutative: true,
+		generic:     true,
+	},
+	{
+		name:        "Add64over",
+		argLen:      2,
+		commutative: true,
+		generic:     true,
+	},
+	{
+		name:        "Add32uover",
+		argLen:      2,
+		commutative: true,
+		generic:     true,
+	},
+	{
+		name:        "Add64uover",
+		argLen:      2,
+		commutative: true,
+		generic:     true,
+	},
+	{
+		name:    "Sub32over",
+		argLen:  2,
+		generic: true,
+	},
+	{
+		name:    "Sub64over",
+		argLen:  2,
+		generic: true,
+	},
+	{
+		name:    "Sub32uover",
+		argLen:  2,
+		generic: true,
+	},
+	{
+		name:    "Sub64uover",
+		argLen:  2,
+		generic: true,
+	},
+	{
+		name:        "Mul32over",
+		argLen:      2,
+		commutative: true,
+		generic:     true,
+	},
+	{
+		name:        "Mul64over",
+		argLen:      2,
+		commutative: true,
+		generic:     true,
+	},
 	{
 		name:    "Avg32u",
 		argLen:  2,
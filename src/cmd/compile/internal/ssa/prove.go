@@ -472,6 +472,18 @@ func (l limit) popcount(b uint) limit {
 	return noLimit().unsignedMinMax(min, max)
 }
 
+// magnitude returns the largest absolute value in the signed range of l.
+func (l limit) magnitude() uint64 {
+	lo, hi := uint64(l.min), uint64(l.max)
+	if l.min < 0 {
+		lo = -lo
+	}
+	if l.max < 0 {
+		hi = -hi
+	}
+	return max(lo, hi)
+}
+
 func (l limit) constValue() (_ int64, ok bool) {
 	switch {
 	case l.min == l.max:
@@ -1975,6 +1987,24 @@ func (ft *factsTable) flowLimit(v *Value) {
 		ft.modLimit(true, v, v.Args[0], v.Args[1])
 	case OpMod64u, OpMod32u, OpMod16u, OpMod8u:
 		ft.modLimit(false, v, v.Args[0], v.Args[1])
+	case OpSelect0:
+		// The first result of a checked arithmetic op is the wrapped result
+		// of the plain op, so it has the same limits.
+		c, ok := checkedArithOps[v.Args[0].Op]
+		if !ok {
+			break
+		}
+		a := ft.limits[v.Args[0].Args[0].ID]
+		b := ft.limits[v.Args[0].Args[1].ID]
+		bitsize := uint(v.Type.Size()) * 8
+		switch c.op {
+		case OpAdd64, OpAdd32:
+			ft.newLimit(v, a.add(b, bitsize))
+		case OpSub64, OpSub32:
+			ft.newLimit(v, a.sub(b, bitsize))
+		case OpMul64, OpMul32:
+			ft.newLimit(v, a.mul(b, bitsize))
+		}
 
 	case OpPhi:
 		// Compute the union of all the input phis.
@@ -2643,6 +2673,91 @@ func addLocalFactsPhi(ft *factsTable, v *Value) {
 	ft.update(b, v, y, dom, rel)
 }
 
+// checkedArithOps maps the checked arithmetic ops used by overflow
+// detection to the plain op computing their first result.
+var checkedArithOps = map[Op]struct {
+	op     Op
+	signed bool
+}{
+	OpAdd32over:  {OpAdd32, true},
+	OpAdd64over:  {OpAdd64, true},
+	OpAdd32uover: {OpAdd32, false},
+	OpAdd64uover: {OpAdd64, false},
+	OpSub32over:  {OpSub32, true},
+	OpSub64over:  {OpSub64, true},
+	OpSub32uover: {OpSub32, false},
+	OpSub64uover: {OpSub64, false},
+	OpMul32over:  {OpMul32, true},
+	OpMul64over:  {OpMul64, true},
+	OpMul32uover: {OpMul32, false},
+	OpMul64uover: {OpMul64, false},
+}
+
+// cannotOverflow reports whether the checked arithmetic op v is known
+// never to set its overflow bit, given the limits of its arguments.
+func (ft *factsTable) cannotOverflow(v *Value) bool {
+	c := checkedArithOps[v.Op]
+	x := ft.limits[v.Args[0].ID]
+	y := ft.limits[v.Args[1].ID]
+	b := uint(v.Args[0].Type.Size()) * 8
+	switch {
+	case c.op == OpAdd64 || c.op == OpAdd32:
+		if !c.signed {
+			_, ok := safeAddU(x.umax, y.umax, b)
+			return ok
+		}
+		_, minOk := safeAdd(x.min, y.min, b)
+		_, maxOk := safeAdd(x.max, y.max, b)
+		return minOk && maxOk
+	case c.op == OpSub64 || c.op == OpSub32:
+		if !c.signed {
+			return x.umin >= y.umax
+		}
+		_, minOk := safeSub(x.min, y.max, b)
+		_, maxOk := safeSub(x.max, y.min, b)
+		return minOk && maxOk
+	case c.op == OpMul64 || c.op == OpMul32:
+		if !c.signed {
+			hi, lo := bits.Mul64(x.umax, y.umax)
+			return hi == 0 && fitsInBitsU(lo, b)
+		}
+		// The product is at most the product of the largest magnitudes,
+		// and any magnitude below 1<<(b-1) fits either sign.
+		hi, lo := bits.Mul64(x.magnitude(), y.magnitude())
+		return hi == 0 && lo < 1<<(b-1)
+	}
+	return false
+}
+
+// untruncated returns x if v is an extension of (TruncNtoM x) back to the
+// size of x and x is known to fit in the truncated type, so v == x.
+// It returns nil otherwise.
+func (ft *factsTable) untruncated(v *Value) *Value {
+	t := v.Args[0]
+	switch t.Op {
+	case OpTrunc16to8, OpTrunc32to8, OpTrunc32to16, OpTrunc64to8, OpTrunc64to16, OpTrunc64to32:
+	default:
+		return nil
+	}
+	x := t.Args[0]
+	if x.Type.Size() != v.Type.Size() {
+		return nil
+	}
+	xl := ft.limits[x.ID]
+	b := uint(t.Type.Size()) * 8
+	switch v.Op {
+	case OpSignExt8to16, OpSignExt8to32, OpSignExt8to64, OpSignExt16to32, OpSignExt16to64, OpSignExt32to64:
+		if !fitsInBits(xl.min, b) || !fitsInBits(xl.max, b) {
+			return nil
+		}
+	default:
+		if !fitsInBitsU(xl.umax, b) {
+			return nil
+		}
+	}
+	return x
+}
+
 var ctzNonZeroOp = map[Op]Op{
 	OpCtz8:  OpCtz8NonZero,
 	OpCtz16: OpCtz16NonZero,
@@ -2886,6 +3001,36 @@ func simplifyBlock(sdom SparseTree, ft *factsTable, b *Block) {
 					b.Func.Warnl(v.Pos, "Rewrote Mul %v into CondSelect; %v is bool", v, x)
 				}
 			}
+		case OpSelect0, OpSelect1:
+			// Remove overflow checks that can never fire.
+			// The first result becomes the plain op and the
+			// overflow bit becomes false, leaving the checked op dead.
+			t := v.Args[0]
+			c, ok := checkedArithOps[t.Op]
+			if !ok || !ft.cannotOverflow(t) {
+				break
+			}
+			if v.Op == OpSelect0 {
+				v.reset(c.op)
+				v.AddArg2(t.Args[0], t.Args[1])
+				break
+			}
+			v.reset(OpConstBool)
+			v.AuxInt = 0
+			if b.Func.pass.debug > 0 {
+				b.Func.Warnl(v.Pos, "Proved %v does not overflow", t.Op)
+			}
+		case OpZeroExt8to16, OpZeroExt8to32, OpZeroExt8to64, OpZeroExt16to32, OpZeroExt16to64, OpZeroExt32to64,
+			OpSignExt8to16, OpSignExt8to32, OpSignExt8to64, OpSignExt16to32, OpSignExt16to64, OpSignExt32to64:
+			// Extending a truncated value back to its original size is
+			// a no-op when the value fits in the truncated type. This is
+			// how overflow checks on 8- and 16-bit arithmetic are done.
+			if x := ft.untruncated(v); x != nil {
+				if b.Func.pass.debug > 0 {
+					b.Func.Warnl(v.Pos, "Proved %v of %v is a no-op", v.Op, v.Args[0].Op)
+				}
+				v.copyOf(x)
+			}
 		case OpEq64, OpEq32, OpEq16, OpEq8,
 			OpNeq64, OpNeq32, OpNeq16, OpNeq8:
 			// Canonicalize:
@@ -2725,6 +2725,28 @@ func bitsMulU32(x, y int32) (r struct{ hi, lo int32 }) {
 	return
 }
 
+// addOverflows64 reports whether x+y overflows int64.
+func addOverflows64(x, y int64) bool {
+	return (x+y < x) != (y < 0)
+}
+
+// subOverflows64 reports whether x-y overflows int64.
+func subOverflows64(x, y int64) bool {
+	return (x-y < x) != (y > 0)
+}
+
+// mulOverflows64 reports whether x*y overflows int64.
+func mulOverflows64(x, y int64) bool {
+	if x == 0 || y == 0 {
+		return false
+	}
+	if x == -1 || y == -1 {
+		// MinInt64 * -1 wraps to MinInt64, which the division test below misses.
+		return x == math.MinInt64 || y == math.MinInt64
+	}
+	return x*y/y != x
+}
+
 // flagify rewrites v which is (X ...) to (Select0 (Xflags ...)).
 func flagify(v *Value) bool {
 	var flagVersion Op
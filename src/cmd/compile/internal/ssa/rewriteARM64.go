@@ -334,6 +334,8 @@ func rewriteValueARM64(v *Value) bool {
 		return rewriteValueARM64_OpARM64ORshiftRL(v)
 	case OpARM64ORshiftRO:
 		return rewriteValueARM64_OpARM64ORshiftRO(v)
+	case OpARM64Overflow:
+		return rewriteValueARM64_OpARM64Overflow(v)
 	case OpARM64REV:
 		return rewriteValueARM64_OpARM64REV(v)
 	case OpARM64REVW:
@@ -14634,6 +14636,21 @@ func rewriteValueARM64_OpARM64ORshiftRO(v *Value) bool {
 	}
 	return false
 }
+func rewriteValueARM64_OpARM64Overflow(v *Value) bool {
+	v_0 := v.Args[0]
+	// match: (Overflow (FlagConstant [fc]))
+	// result: (MOVDconst [b2i(fc.V())])
+	for {
+		if v_0.Op != OpARM64FlagConstant {
+			break
+		}
+		fc := auxIntToFlagConstant(v_0.AuxInt)
+		v.reset(OpARM64MOVDconst)
+		v.AuxInt = int64ToAuxInt(b2i(fc.V()))
+		return true
+	}
+	return false
+}
 func rewriteValueARM64_OpARM64REV(v *Value) bool {
 	v_0 := v.Args[0]
 	// match: (REV (REV p))
@@ -22018,6 +22035,150 @@ func rewriteValueARM64_OpSelect0(v *Value) bool {
 		v.AddArg2(x, y)
 		return true
 	}
+	// match: (Select0 (Mul32uover x y))
+	// result: (MUL x y)
+	for {
+		if v_0.Op != OpMul32uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64MUL)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Add64over x y))
+	// result: (Select0 <typ.UInt64> (ADDSflags x y))
+	for {
+		if v_0.Op != OpAdd64over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpSelect0)
+		v.Type = typ.UInt64
+		v0 := b.NewValue0(v.Pos, OpARM64ADDSflags, types.NewTuple(typ.UInt64, types.TypeFlags))
+		v0.AddArg2(x, y)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select0 (Add64uover x y))
+	// result: (Select0 <typ.UInt64> (ADDSflags x y))
+	for {
+		if v_0.Op != OpAdd64uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpSelect0)
+		v.Type = typ.UInt64
+		v0 := b.NewValue0(v.Pos, OpARM64ADDSflags, types.NewTuple(typ.UInt64, types.TypeFlags))
+		v0.AddArg2(x, y)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select0 (Sub64over x y))
+	// result: (Select0 <typ.UInt64> (SUBSflags x y))
+	for {
+		if v_0.Op != OpSub64over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpSelect0)
+		v.Type = typ.UInt64
+		v0 := b.NewValue0(v.Pos, OpARM64SUBSflags, types.NewTuple(typ.UInt64, types.TypeFlags))
+		v0.AddArg2(x, y)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select0 (Sub64uover x y))
+	// result: (Select0 <typ.UInt64> (SUBSflags x y))
+	for {
+		if v_0.Op != OpSub64uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpSelect0)
+		v.Type = typ.UInt64
+		v0 := b.NewValue0(v.Pos, OpARM64SUBSflags, types.NewTuple(typ.UInt64, types.TypeFlags))
+		v0.AddArg2(x, y)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select0 (Mul64over x y))
+	// result: (MUL x y)
+	for {
+		if v_0.Op != OpMul64over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64MUL)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Add32over x y))
+	// result: (ADD x y)
+	for {
+		if v_0.Op != OpAdd32over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64ADD)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Add32uover x y))
+	// result: (ADD x y)
+	for {
+		if v_0.Op != OpAdd32uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64ADD)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Sub32over x y))
+	// result: (SUB x y)
+	for {
+		if v_0.Op != OpSub32over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64SUB)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Sub32uover x y))
+	// result: (SUB x y)
+	for {
+		if v_0.Op != OpSub32uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64SUB)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Mul32over x y))
+	// result: (MUL x y)
+	for {
+		if v_0.Op != OpMul32over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64MUL)
+		v.AddArg2(x, y)
+		return true
+	}
 	return false
 }
 func rewriteValueARM64_OpSelect1(v *Value) bool {
@@ -22100,6 +22261,215 @@ func rewriteValueARM64_OpSelect1(v *Value) bool {
 		v.AddArg(v0)
 		return true
 	}
+	// match: (Select1 (Mul32uover x y))
+	// result: (NotEqual (CMPconst (SRLconst <typ.UInt64> [32] (UMULL <typ.UInt64> x y)) [0]))
+	for {
+		if v_0.Op != OpMul32uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64NotEqual)
+		v0 := b.NewValue0(v.Pos, OpARM64CMPconst, types.TypeFlags)
+		v0.AuxInt = int64ToAuxInt(0)
+		v1 := b.NewValue0(v.Pos, OpARM64SRLconst, typ.UInt64)
+		v1.AuxInt = int64ToAuxInt(32)
+		v2 := b.NewValue0(v.Pos, OpARM64UMULL, typ.UInt64)
+		v2.AddArg2(x, y)
+		v1.AddArg(v2)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Add64over x y))
+	// result: (Overflow (Select1 <types.TypeFlags> (ADDSflags x y)))
+	for {
+		if v_0.Op != OpAdd64over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64Overflow)
+		v0 := b.NewValue0(v.Pos, OpSelect1, types.TypeFlags)
+		v1 := b.NewValue0(v.Pos, OpARM64ADDSflags, types.NewTuple(typ.UInt64, types.TypeFlags))
+		v1.AddArg2(x, y)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Add64uover x y))
+	// result: (GreaterEqualU (Select1 <types.TypeFlags> (ADDSflags x y)))
+	for {
+		if v_0.Op != OpAdd64uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64GreaterEqualU)
+		v0 := b.NewValue0(v.Pos, OpSelect1, types.TypeFlags)
+		v1 := b.NewValue0(v.Pos, OpARM64ADDSflags, types.NewTuple(typ.UInt64, types.TypeFlags))
+		v1.AddArg2(x, y)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Sub64over x y))
+	// result: (Overflow (Select1 <types.TypeFlags> (SUBSflags x y)))
+	for {
+		if v_0.Op != OpSub64over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64Overflow)
+		v0 := b.NewValue0(v.Pos, OpSelect1, types.TypeFlags)
+		v1 := b.NewValue0(v.Pos, OpARM64SUBSflags, types.NewTuple(typ.UInt64, types.TypeFlags))
+		v1.AddArg2(x, y)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Sub64uover x y))
+	// result: (LessThanU (Select1 <types.TypeFlags> (SUBSflags x y)))
+	for {
+		if v_0.Op != OpSub64uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64LessThanU)
+		v0 := b.NewValue0(v.Pos, OpSelect1, types.TypeFlags)
+		v1 := b.NewValue0(v.Pos, OpARM64SUBSflags, types.NewTuple(typ.UInt64, types.TypeFlags))
+		v1.AddArg2(x, y)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Mul64over x y))
+	// result: (NotEqual (CMP (MULH <typ.Int64> x y) (SRAconst <typ.Int64> [63] (MUL <typ.Int64> x y))))
+	for {
+		if v_0.Op != OpMul64over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64NotEqual)
+		v0 := b.NewValue0(v.Pos, OpARM64CMP, types.TypeFlags)
+		v1 := b.NewValue0(v.Pos, OpARM64MULH, typ.Int64)
+		v1.AddArg2(x, y)
+		v2 := b.NewValue0(v.Pos, OpARM64SRAconst, typ.Int64)
+		v2.AuxInt = int64ToAuxInt(63)
+		v3 := b.NewValue0(v.Pos, OpARM64MUL, typ.Int64)
+		v3.AddArg2(x, y)
+		v2.AddArg(v3)
+		v0.AddArg2(v1, v2)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Add32over x y))
+	// result: (NotEqual (CMP (ADD <typ.Int64> (MOVWreg <typ.Int64> x) (MOVWreg <typ.Int64> y)) (MOVWreg <typ.Int64> (ADD <typ.Int32> x y))))
+	for {
+		if v_0.Op != OpAdd32over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64NotEqual)
+		v0 := b.NewValue0(v.Pos, OpARM64CMP, types.TypeFlags)
+		v1 := b.NewValue0(v.Pos, OpARM64ADD, typ.Int64)
+		v2 := b.NewValue0(v.Pos, OpARM64MOVWreg, typ.Int64)
+		v2.AddArg(x)
+		v3 := b.NewValue0(v.Pos, OpARM64MOVWreg, typ.Int64)
+		v3.AddArg(y)
+		v1.AddArg2(v2, v3)
+		v4 := b.NewValue0(v.Pos, OpARM64MOVWreg, typ.Int64)
+		v5 := b.NewValue0(v.Pos, OpARM64ADD, typ.Int32)
+		v5.AddArg2(x, y)
+		v4.AddArg(v5)
+		v0.AddArg2(v1, v4)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Add32uover x y))
+	// result: (NotEqual (CMPconst (SRLconst <typ.UInt64> [32] (ADD <typ.UInt64> (MOVWUreg <typ.UInt64> x) (MOVWUreg <typ.UInt64> y))) [0]))
+	for {
+		if v_0.Op != OpAdd32uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64NotEqual)
+		v0 := b.NewValue0(v.Pos, OpARM64CMPconst, types.TypeFlags)
+		v0.AuxInt = int64ToAuxInt(0)
+		v1 := b.NewValue0(v.Pos, OpARM64SRLconst, typ.UInt64)
+		v1.AuxInt = int64ToAuxInt(32)
+		v2 := b.NewValue0(v.Pos, OpARM64ADD, typ.UInt64)
+		v3 := b.NewValue0(v.Pos, OpARM64MOVWUreg, typ.UInt64)
+		v3.AddArg(x)
+		v4 := b.NewValue0(v.Pos, OpARM64MOVWUreg, typ.UInt64)
+		v4.AddArg(y)
+		v2.AddArg2(v3, v4)
+		v1.AddArg(v2)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Sub32over x y))
+	// result: (NotEqual (CMP (SUB <typ.Int64> (MOVWreg <typ.Int64> x) (MOVWreg <typ.Int64> y)) (MOVWreg <typ.Int64> (SUB <typ.Int32> x y))))
+	for {
+		if v_0.Op != OpSub32over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64NotEqual)
+		v0 := b.NewValue0(v.Pos, OpARM64CMP, types.TypeFlags)
+		v1 := b.NewValue0(v.Pos, OpARM64SUB, typ.Int64)
+		v2 := b.NewValue0(v.Pos, OpARM64MOVWreg, typ.Int64)
+		v2.AddArg(x)
+		v3 := b.NewValue0(v.Pos, OpARM64MOVWreg, typ.Int64)
+		v3.AddArg(y)
+		v1.AddArg2(v2, v3)
+		v4 := b.NewValue0(v.Pos, OpARM64MOVWreg, typ.Int64)
+		v5 := b.NewValue0(v.Pos, OpARM64SUB, typ.Int32)
+		v5.AddArg2(x, y)
+		v4.AddArg(v5)
+		v0.AddArg2(v1, v4)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Sub32uover x y))
+	// result: (LessThanU (CMPW x y))
+	for {
+		if v_0.Op != OpSub32uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64LessThanU)
+		v0 := b.NewValue0(v.Pos, OpARM64CMPW, types.TypeFlags)
+		v0.AddArg2(x, y)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Mul32over x y))
+	// result: (NotEqual (CMP (MULL <typ.Int64> x y) (MOVWreg <typ.Int64> (MULL <typ.Int64> x y))))
+	for {
+		if v_0.Op != OpMul32over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpARM64NotEqual)
+		v0 := b.NewValue0(v.Pos, OpARM64CMP, types.TypeFlags)
+		v1 := b.NewValue0(v.Pos, OpARM64MULL, typ.Int64)
+		v1.AddArg2(x, y)
+		v2 := b.NewValue0(v.Pos, OpARM64MOVWreg, typ.Int64)
+		v2.AddArg(v1)
+		v0.AddArg2(v1, v2)
+		v.AddArg(v0)
+		return true
+	}
 	return false
 }
 func rewriteValueARM64_OpSelectN(v *Value) bool {
@@ -23943,6 +24313,14 @@ func rewriteBlockARM64(b *Block) bool {
 			b.resetWithControl(BlockARM64FGE, cc)
 			return true
 		}
+		// match: (If (Overflow cc) yes no)
+		// result: (VS cc yes no)
+		for b.Controls[0].Op == OpARM64Overflow {
+			v_0 := b.Controls[0]
+			cc := v_0.Args[0]
+			b.resetWithControl(BlockARM64VS, cc)
+			return true
+		}
 		// match: (If cond yes no)
 		// result: (TBNZ [0] cond yes no)
 		for {
@@ -25599,6 +25977,17 @@ func rewriteBlockARM64(b *Block) bool {
 			b.resetWithControl(BlockARM64FGE, cc)
 			return true
 		}
+		// match: (TBNZ [0] (Overflow cc) yes no)
+		// result: (VS cc yes no)
+		for b.Controls[0].Op == OpARM64Overflow {
+			v_0 := b.Controls[0]
+			cc := v_0.Args[0]
+			if auxIntToInt64(b.AuxInt) != 0 {
+				break
+			}
+			b.resetWithControl(BlockARM64VS, cc)
+			return true
+		}
 		// match: (TBNZ [0] (XORconst [1] x) yes no)
 		// result: (TBZ [0] x yes no)
 		for b.Controls[0].Op == OpARM64XORconst {
@@ -26026,6 +26415,32 @@ func rewriteBlockARM64(b *Block) bool {
 			b.resetWithControl(BlockARM64UGT, cmp)
 			return true
 		}
+	case BlockARM64VS:
+		// match: (VS (FlagConstant [fc]) yes no)
+		// cond: fc.V()
+		// result: (First yes no)
+		for b.Controls[0].Op == OpARM64FlagConstant {
+			v_0 := b.Controls[0]
+			fc := auxIntToFlagConstant(v_0.AuxInt)
+			if !(fc.V()) {
+				break
+			}
+			b.Reset(BlockFirst)
+			return true
+		}
+		// match: (VS (FlagConstant [fc]) yes no)
+		// cond: !fc.V()
+		// result: (First no yes)
+		for b.Controls[0].Op == OpARM64FlagConstant {
+			v_0 := b.Controls[0]
+			fc := auxIntToFlagConstant(v_0.AuxInt)
+			if !(!fc.V()) {
+				break
+			}
+			b.Reset(BlockFirst)
+			b.swapSuccessors()
+			return true
+		}
 	case BlockARM64Z:
 		// match: (Z sub:(SUB x y))
 		// cond: sub.Uses == 1
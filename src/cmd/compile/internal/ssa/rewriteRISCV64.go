@@ -10285,6 +10285,138 @@ func rewriteValueRISCV64_OpSelect0(v *Value) bool {
 	v_0 := v.Args[0]
 	b := v.Block
 	typ := &b.Func.Config.Types
+	// match: (Select0 (Add64over x y))
+	// result: (ADD x y)
+	for {
+		if v_0.Op != OpAdd64over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64ADD)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Add64uover x y))
+	// result: (ADD x y)
+	for {
+		if v_0.Op != OpAdd64uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64ADD)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Sub64over x y))
+	// result: (SUB x y)
+	for {
+		if v_0.Op != OpSub64over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SUB)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Sub64uover x y))
+	// result: (SUB x y)
+	for {
+		if v_0.Op != OpSub64uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SUB)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Mul64over x y))
+	// result: (MUL x y)
+	for {
+		if v_0.Op != OpMul64over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64MUL)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Add32over x y))
+	// result: (ADD x y)
+	for {
+		if v_0.Op != OpAdd32over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64ADD)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Add32uover x y))
+	// result: (ADD x y)
+	for {
+		if v_0.Op != OpAdd32uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64ADD)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Sub32over x y))
+	// result: (SUB x y)
+	for {
+		if v_0.Op != OpSub32over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SUB)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Sub32uover x y))
+	// result: (SUB x y)
+	for {
+		if v_0.Op != OpSub32uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SUB)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Mul32over x y))
+	// result: (MUL x y)
+	for {
+		if v_0.Op != OpMul32over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64MUL)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select0 (Mul32uover x y))
+	// result: (MUL x y)
+	for {
+		if v_0.Op != OpMul32uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64MUL)
+		v.AddArg2(x, y)
+		return true
+	}
 	// match: (Select0 (Add64carry x y c))
 	// result: (ADD (ADD <typ.UInt64> x y) c)
 	for {
@@ -10338,6 +10470,221 @@ func rewriteValueRISCV64_OpSelect1(v *Value) bool {
 	v_0 := v.Args[0]
 	b := v.Block
 	typ := &b.Func.Config.Types
+	// match: (Select1 (Add64over x y))
+	// result: (XOR (SLT <typ.Bool> (ADD <typ.Int64> x y) x) (SLTI <typ.Bool> [0] y))
+	for {
+		if v_0.Op != OpAdd64over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64XOR)
+		v0 := b.NewValue0(v.Pos, OpRISCV64SLT, typ.Bool)
+		v1 := b.NewValue0(v.Pos, OpRISCV64ADD, typ.Int64)
+		v1.AddArg2(x, y)
+		v0.AddArg2(v1, x)
+		v2 := b.NewValue0(v.Pos, OpRISCV64SLTI, typ.Bool)
+		v2.AuxInt = int64ToAuxInt(0)
+		v2.AddArg(y)
+		v.AddArg2(v0, v2)
+		return true
+	}
+	// match: (Select1 (Add64uover x y))
+	// result: (SLTU (ADD <typ.UInt64> x y) x)
+	for {
+		if v_0.Op != OpAdd64uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SLTU)
+		v0 := b.NewValue0(v.Pos, OpRISCV64ADD, typ.UInt64)
+		v0.AddArg2(x, y)
+		v.AddArg2(v0, x)
+		return true
+	}
+	// match: (Select1 (Sub64over x y))
+	// result: (XOR (SLT <typ.Bool> x (SUB <typ.Int64> x y)) (SLTI <typ.Bool> [0] y))
+	for {
+		if v_0.Op != OpSub64over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64XOR)
+		v0 := b.NewValue0(v.Pos, OpRISCV64SLT, typ.Bool)
+		v1 := b.NewValue0(v.Pos, OpRISCV64SUB, typ.Int64)
+		v1.AddArg2(x, y)
+		v0.AddArg2(x, v1)
+		v2 := b.NewValue0(v.Pos, OpRISCV64SLTI, typ.Bool)
+		v2.AuxInt = int64ToAuxInt(0)
+		v2.AddArg(y)
+		v.AddArg2(v0, v2)
+		return true
+	}
+	// match: (Select1 (Sub64uover x y))
+	// result: (SLTU x y)
+	for {
+		if v_0.Op != OpSub64uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SLTU)
+		v.AddArg2(x, y)
+		return true
+	}
+	// match: (Select1 (Mul64over x y))
+	// result: (SNEZ (XOR <typ.Int64> (MULH <typ.Int64> x y) (SRAI <typ.Int64> [63] (MUL <typ.Int64> x y))))
+	for {
+		if v_0.Op != OpMul64over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SNEZ)
+		v0 := b.NewValue0(v.Pos, OpRISCV64XOR, typ.Int64)
+		v1 := b.NewValue0(v.Pos, OpRISCV64MULH, typ.Int64)
+		v1.AddArg2(x, y)
+		v2 := b.NewValue0(v.Pos, OpRISCV64SRAI, typ.Int64)
+		v2.AuxInt = int64ToAuxInt(63)
+		v3 := b.NewValue0(v.Pos, OpRISCV64MUL, typ.Int64)
+		v3.AddArg2(x, y)
+		v2.AddArg(v3)
+		v0.AddArg2(v1, v2)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Add32over x y))
+	// result: (SNEZ (SUB <typ.Int64> (ADD <typ.Int64> (MOVWreg <typ.Int64> x) (MOVWreg <typ.Int64> y)) (MOVWreg <typ.Int64> (ADD <typ.Int32> x y))))
+	for {
+		if v_0.Op != OpAdd32over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SNEZ)
+		v0 := b.NewValue0(v.Pos, OpRISCV64SUB, typ.Int64)
+		v1 := b.NewValue0(v.Pos, OpRISCV64ADD, typ.Int64)
+		v2 := b.NewValue0(v.Pos, OpRISCV64MOVWreg, typ.Int64)
+		v2.AddArg(x)
+		v3 := b.NewValue0(v.Pos, OpRISCV64MOVWreg, typ.Int64)
+		v3.AddArg(y)
+		v1.AddArg2(v2, v3)
+		v4 := b.NewValue0(v.Pos, OpRISCV64MOVWreg, typ.Int64)
+		v5 := b.NewValue0(v.Pos, OpRISCV64ADD, typ.Int32)
+		v5.AddArg2(x, y)
+		v4.AddArg(v5)
+		v0.AddArg2(v1, v4)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Add32uover x y))
+	// result: (SNEZ (SRLI <typ.UInt64> [32] (ADD <typ.UInt64> (MOVWUreg <typ.UInt64> x) (MOVWUreg <typ.UInt64> y))))
+	for {
+		if v_0.Op != OpAdd32uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SNEZ)
+		v0 := b.NewValue0(v.Pos, OpRISCV64SRLI, typ.UInt64)
+		v0.AuxInt = int64ToAuxInt(32)
+		v1 := b.NewValue0(v.Pos, OpRISCV64ADD, typ.UInt64)
+		v2 := b.NewValue0(v.Pos, OpRISCV64MOVWUreg, typ.UInt64)
+		v2.AddArg(x)
+		v3 := b.NewValue0(v.Pos, OpRISCV64MOVWUreg, typ.UInt64)
+		v3.AddArg(y)
+		v1.AddArg2(v2, v3)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Sub32over x y))
+	// result: (SNEZ (SUB <typ.Int64> (SUB <typ.Int64> (MOVWreg <typ.Int64> x) (MOVWreg <typ.Int64> y)) (MOVWreg <typ.Int64> (SUB <typ.Int32> x y))))
+	for {
+		if v_0.Op != OpSub32over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SNEZ)
+		v0 := b.NewValue0(v.Pos, OpRISCV64SUB, typ.Int64)
+		v1 := b.NewValue0(v.Pos, OpRISCV64SUB, typ.Int64)
+		v2 := b.NewValue0(v.Pos, OpRISCV64MOVWreg, typ.Int64)
+		v2.AddArg(x)
+		v3 := b.NewValue0(v.Pos, OpRISCV64MOVWreg, typ.Int64)
+		v3.AddArg(y)
+		v1.AddArg2(v2, v3)
+		v4 := b.NewValue0(v.Pos, OpRISCV64MOVWreg, typ.Int64)
+		v5 := b.NewValue0(v.Pos, OpRISCV64SUB, typ.Int32)
+		v5.AddArg2(x, y)
+		v4.AddArg(v5)
+		v0.AddArg2(v1, v4)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Sub32uover x y))
+	// result: (SLTU (MOVWUreg <typ.UInt64> x) (MOVWUreg <typ.UInt64> y))
+	for {
+		if v_0.Op != OpSub32uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SLTU)
+		v0 := b.NewValue0(v.Pos, OpRISCV64MOVWUreg, typ.UInt64)
+		v0.AddArg(x)
+		v1 := b.NewValue0(v.Pos, OpRISCV64MOVWUreg, typ.UInt64)
+		v1.AddArg(y)
+		v.AddArg2(v0, v1)
+		return true
+	}
+	// match: (Select1 (Mul32over x y))
+	// result: (SNEZ (SUB <typ.Int64> (MUL <typ.Int64> (MOVWreg <typ.Int64> x) (MOVWreg <typ.Int64> y)) (MOVWreg <typ.Int64> (MUL <typ.Int32> x y))))
+	for {
+		if v_0.Op != OpMul32over {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SNEZ)
+		v0 := b.NewValue0(v.Pos, OpRISCV64SUB, typ.Int64)
+		v1 := b.NewValue0(v.Pos, OpRISCV64MUL, typ.Int64)
+		v2 := b.NewValue0(v.Pos, OpRISCV64MOVWreg, typ.Int64)
+		v2.AddArg(x)
+		v3 := b.NewValue0(v.Pos, OpRISCV64MOVWreg, typ.Int64)
+		v3.AddArg(y)
+		v1.AddArg2(v2, v3)
+		v4 := b.NewValue0(v.Pos, OpRISCV64MOVWreg, typ.Int64)
+		v5 := b.NewValue0(v.Pos, OpRISCV64MUL, typ.Int32)
+		v5.AddArg2(x, y)
+		v4.AddArg(v5)
+		v0.AddArg2(v1, v4)
+		v.AddArg(v0)
+		return true
+	}
+	// match: (Select1 (Mul32uover x y))
+	// result: (SNEZ (SRLI <typ.UInt64> [32] (MUL <typ.UInt64> (MOVWUreg <typ.UInt64> x) (MOVWUreg <typ.UInt64> y))))
+	for {
+		if v_0.Op != OpMul32uover {
+			break
+		}
+		y := v_0.Args[1]
+		x := v_0.Args[0]
+		v.reset(OpRISCV64SNEZ)
+		v0 := b.NewValue0(v.Pos, OpRISCV64SRLI, typ.UInt64)
+		v0.AuxInt = int64ToAuxInt(32)
+		v1 := b.NewValue0(v.Pos, OpRISCV64MUL, typ.UInt64)
+		v2 := b.NewValue0(v.Pos, OpRISCV64MOVWUreg, typ.UInt64)
+		v2.AddArg(x)
+		v3 := b.NewValue0(v.Pos, OpRISCV64MOVWUreg, typ.UInt64)
+		v3.AddArg(y)
+		v1.AddArg2(v2, v3)
+		v0.AddArg(v1)
+		v.AddArg(v0)
+		return true
+	}
 	// match: (Select1 (Add64carry x y c))
 	// result: (OR (SLTU <typ.UInt64> s:(ADD <typ.UInt64> x y) x) (SLTU <typ.UInt64> (ADD <typ.UInt64> s c) s))
 	for {
@@ -16,12 +16,20 @@ func rewriteValuegeneric(v *Value) bool {
 		return rewriteValuegeneric_OpAdd32(v)
 	case OpAdd32F:
 		return rewriteValuegeneric_OpAdd32F(v)
+	case OpAdd32over:
+		return rewriteValuegeneric_OpAdd32over(v)
+	case OpAdd32uover:
+		return rewriteValuegeneric_OpAdd32uover(v)
 	case OpAdd64:
 		return rewriteValuegeneric_OpAdd64(v)
 	case OpAdd64F:
 		return rewriteValuegeneric_OpAdd64F(v)
 	case OpAdd64carry:
 		return rewriteValuegeneric_OpAdd64carry(v)
+	case OpAdd64over:
+		return rewriteValuegeneric_OpAdd64over(v)
+	case OpAdd64uover:
+		return rewriteValuegeneric_OpAdd64uover(v)
 	case OpAdd8:
 		return rewriteValuegeneric_OpAdd8(v)
 	case OpAddPtr:
@@ -250,6 +258,8 @@ func rewriteValuegeneric(v *Value) bool {
 		return rewriteValuegeneric_OpMul32(v)
 	case OpMul32F:
 		return rewriteValuegeneric_OpMul32F(v)
+	case OpMul32over:
+		return rewriteValuegeneric_OpMul32over(v)
 	case OpMul32uhilo:
 		return rewriteValuegeneric_OpMul32uhilo(v)
 	case OpMul32uover:
@@ -258,6 +268,8 @@ func rewriteValuegeneric(v *Value) bool {
 		return rewriteValuegeneric_OpMul64(v)
 	case OpMul64F:
 		return rewriteValuegeneric_OpMul64F(v)
+	case OpMul64over:
+		return rewriteValuegeneric_OpMul64over(v)
 	case OpMul64uhilo:
 		return rewriteValuegeneric_OpMul64uhilo(v)
 	case OpMul64uover:
@@ -450,10 +462,18 @@ func rewriteValuegeneric(v *Value) bool {
 		return rewriteValuegeneric_OpSub32(v)
 	case OpSub32F:
 		return rewriteValuegeneric_OpSub32F(v)
+	case OpSub32over:
+		return rewriteValuegeneric_OpSub32over(v)
+	case OpSub32uover:
+		return rewriteValuegeneric_OpSub32uover(v)
 	case OpSub64:
 		return rewriteValuegeneric_OpSub64(v)
 	case OpSub64F:
 		return rewriteValuegeneric_OpSub64F(v)
+	case OpSub64over:
+		return rewriteValuegeneric_OpSub64over(v)
+	case OpSub64uover:
+		return rewriteValuegeneric_OpSub64uover(v)
 	case OpSub8:
 		return rewriteValuegeneric_OpSub8(v)
 	case OpTrunc:
@@ -1748,6 +1768,96 @@ func rewriteValuegeneric_OpAdd32F(v *Value) bool {
 	}
 	return false
 }
+func rewriteValuegeneric_OpAdd32over(v *Value) bool {
+	v_1 := v.Args[1]
+	v_0 := v.Args[0]
+	b := v.Block
+	typ := &b.Func.Config.Types
+	// match: (Add32over (Const32 [c]) (Const32 [d]))
+	// result: (MakeTuple (Const32 <typ.Int32> [c+d]) (ConstBool <typ.Bool> [int64(c)+int64(d) != int64(c+d)]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			if v_0.Op != OpConst32 {
+				continue
+			}
+			c := auxIntToInt32(v_0.AuxInt)
+			if v_1.Op != OpConst32 {
+				continue
+			}
+			d := auxIntToInt32(v_1.AuxInt)
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConst32, typ.Int32)
+			v0.AuxInt = int32ToAuxInt(c + d)
+			v1 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v1.AuxInt = boolToAuxInt(int64(c)+int64(d) != int64(c+d))
+			v.AddArg2(v0, v1)
+			return true
+		}
+		break
+	}
+	// match: (Add32over x (Const32 [0]))
+	// result: (MakeTuple x (ConstBool <typ.Bool> [false]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			x := v_0
+			if v_1.Op != OpConst32 || auxIntToInt32(v_1.AuxInt) != 0 {
+				continue
+			}
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v0.AuxInt = boolToAuxInt(false)
+			v.AddArg2(x, v0)
+			return true
+		}
+		break
+	}
+	return false
+}
+func rewriteValuegeneric_OpAdd32uover(v *Value) bool {
+	v_1 := v.Args[1]
+	v_0 := v.Args[0]
+	b := v.Block
+	typ := &b.Func.Config.Types
+	// match: (Add32uover (Const32 [c]) (Const32 [d]))
+	// result: (MakeTuple (Const32 <typ.UInt32> [c+d]) (ConstBool <typ.Bool> [uint32(c+d) < uint32(c)]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			if v_0.Op != OpConst32 {
+				continue
+			}
+			c := auxIntToInt32(v_0.AuxInt)
+			if v_1.Op != OpConst32 {
+				continue
+			}
+			d := auxIntToInt32(v_1.AuxInt)
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConst32, typ.UInt32)
+			v0.AuxInt = int32ToAuxInt(c + d)
+			v1 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v1.AuxInt = boolToAuxInt(uint32(c+d) < uint32(c))
+			v.AddArg2(v0, v1)
+			return true
+		}
+		break
+	}
+	// match: (Add32uover x (Const32 [0]))
+	// result: (MakeTuple x (ConstBool <typ.Bool> [false]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			x := v_0
+			if v_1.Op != OpConst32 || auxIntToInt32(v_1.AuxInt) != 0 {
+				continue
+			}
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v0.AuxInt = boolToAuxInt(false)
+			v.AddArg2(x, v0)
+			return true
+		}
+		break
+	}
+	return false
+}
 func rewriteValuegeneric_OpAdd64(v *Value) bool {
 	v_1 := v.Args[1]
 	v_0 := v.Args[0]
@@ -2426,6 +2536,96 @@ func rewriteValuegeneric_OpAdd64carry(v *Value) bool {
 	}
 	return false
 }
+func rewriteValuegeneric_OpAdd64over(v *Value) bool {
+	v_1 := v.Args[1]
+	v_0 := v.Args[0]
+	b := v.Block
+	typ := &b.Func.Config.Types
+	// match: (Add64over (Const64 [c]) (Const64 [d]))
+	// result: (MakeTuple (Const64 <typ.Int64> [c+d]) (ConstBool <typ.Bool> [addOverflows64(c, d)]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			if v_0.Op != OpConst64 {
+				continue
+			}
+			c := auxIntToInt64(v_0.AuxInt)
+			if v_1.Op != OpConst64 {
+				continue
+			}
+			d := auxIntToInt64(v_1.AuxInt)
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConst64, typ.Int64)
+			v0.AuxInt = int64ToAuxInt(c + d)
+			v1 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v1.AuxInt = boolToAuxInt(addOverflows64(c, d))
+			v.AddArg2(v0, v1)
+			return true
+		}
+		break
+	}
+	// match: (Add64over x (Const64 [0]))
+	// result: (MakeTuple x (ConstBool <typ.Bool> [false]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			x := v_0
+			if v_1.Op != OpConst64 || auxIntToInt64(v_1.AuxInt) != 0 {
+				continue
+			}
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v0.AuxInt = boolToAuxInt(false)
+			v.AddArg2(x, v0)
+			return true
+		}
+		break
+	}
+	return false
+}
+func rewriteValuegeneric_OpAdd64uover(v *Value) bool {
+	v_1 := v.Args[1]
+	v_0 := v.Args[0]
+	b := v.Block
+	typ := &b.Func.Config.Types
+	// match: (Add64uover (Const64 [c]) (Const64 [d]))
+	// result: (MakeTuple (Const64 <typ.UInt64> [c+d]) (ConstBool <typ.Bool> [uint64(c+d) < uint64(c)]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			if v_0.Op != OpConst64 {
+				continue
+			}
+			c := auxIntToInt64(v_0.AuxInt)
+			if v_1.Op != OpConst64 {
+				continue
+			}
+			d := auxIntToInt64(v_1.AuxInt)
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConst64, typ.UInt64)
+			v0.AuxInt = int64ToAuxInt(c + d)
+			v1 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v1.AuxInt = boolToAuxInt(uint64(c+d) < uint64(c))
+			v.AddArg2(v0, v1)
+			return true
+		}
+		break
+	}
+	// match: (Add64uover x (Const64 [0]))
+	// result: (MakeTuple x (ConstBool <typ.Bool> [false]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			x := v_0
+			if v_1.Op != OpConst64 || auxIntToInt64(v_1.AuxInt) != 0 {
+				continue
+			}
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v0.AuxInt = boolToAuxInt(false)
+			v.AddArg2(x, v0)
+			return true
+		}
+		break
+	}
+	return false
+}
 func rewriteValuegeneric_OpAdd8(v *Value) bool {
 	v_1 := v.Args[1]
 	v_0 := v.Args[0]
@@ -18973,6 +19173,67 @@ func rewriteValuegeneric_OpMul32F(v *Value) bool {
 	}
 	return false
 }
+func rewriteValuegeneric_OpMul32over(v *Value) bool {
+	v_1 := v.Args[1]
+	v_0 := v.Args[0]
+	b := v.Block
+	typ := &b.Func.Config.Types
+	// match: (Mul32over (Const32 [c]) (Const32 [d]))
+	// result: (MakeTuple (Const32 <typ.Int32> [c*d]) (ConstBool <typ.Bool> [int64(c)*int64(d) != int64(c*d)]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			if v_0.Op != OpConst32 {
+				continue
+			}
+			c := auxIntToInt32(v_0.AuxInt)
+			if v_1.Op != OpConst32 {
+				continue
+			}
+			d := auxIntToInt32(v_1.AuxInt)
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConst32, typ.Int32)
+			v0.AuxInt = int32ToAuxInt(c * d)
+			v1 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v1.AuxInt = boolToAuxInt(int64(c)*int64(d) != int64(c*d))
+			v.AddArg2(v0, v1)
+			return true
+		}
+		break
+	}
+	// match: (Mul32over x (Const32 [1]))
+	// result: (MakeTuple x (ConstBool <typ.Bool> [false]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			x := v_0
+			if v_1.Op != OpConst32 || auxIntToInt32(v_1.AuxInt) != 1 {
+				continue
+			}
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v0.AuxInt = boolToAuxInt(false)
+			v.AddArg2(x, v0)
+			return true
+		}
+		break
+	}
+	// match: (Mul32over x z:(Const32 [0]))
+	// result: (MakeTuple z (ConstBool <typ.Bool> [false]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			z := v_1
+			if z.Op != OpConst32 || auxIntToInt32(z.AuxInt) != 0 {
+				continue
+			}
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v0.AuxInt = boolToAuxInt(false)
+			v.AddArg2(z, v0)
+			return true
+		}
+		break
+	}
+	return false
+}
 func rewriteValuegeneric_OpMul32uhilo(v *Value) bool {
 	v_1 := v.Args[1]
 	v_0 := v.Args[0]
@@ -19029,6 +19290,38 @@ func rewriteValuegeneric_OpMul32uover(v *Value) bool {
 		}
 		break
 	}
+	// match: (Mul32uover x (Const32 [1]))
+	// result: (MakeTuple x (ConstBool <typ.Bool> [false]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			x := v_0
+			if v_1.Op != OpConst32 || auxIntToInt32(v_1.AuxInt) != 1 {
+				continue
+			}
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v0.AuxInt = boolToAuxInt(false)
+			v.AddArg2(x, v0)
+			return true
+		}
+		break
+	}
+	// match: (Mul32uover x z:(Const32 [0]))
+	// result: (MakeTuple z (ConstBool <typ.Bool> [false]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			z := v_1
+			if z.Op != OpConst32 || auxIntToInt32(z.AuxInt) != 0 {
+				continue
+			}
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v0.AuxInt = boolToAuxInt(false)
+			v.AddArg2(z, v0)
+			return true
+		}
+		break
+	}
 	// match: (Mul32uover <t> (Const32 [1]) x)
 	// result: (MakeTuple x (ConstBool <t.FieldType(1)> [false]))
 	for {
@@ -19384,6 +19677,67 @@ func rewriteValuegeneric_OpMul64F(v *Value) bool {
 	}
 	return false
 }
+func rewriteValuegeneric_OpMul64over(v *Value) bool {
+	v_1 := v.Args[1]
+	v_0 := v.Args[0]
+	b := v.Block
+	typ := &b.Func.Config.Types
+	// match: (Mul64over (Const64 [c]) (Const64 [d]))
+	// result: (MakeTuple (Const64 <typ.Int64> [c*d]) (ConstBool <typ.Bool> [mulOverflows64(c, d)]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			if v_0.Op != OpConst64 {
+				continue
+			}
+			c := auxIntToInt64(v_0.AuxInt)
+			if v_1.Op != OpConst64 {
+				continue
+			}
+			d := auxIntToInt64(v_1.AuxInt)
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConst64, typ.Int64)
+			v0.AuxInt = int64ToAuxInt(c * d)
+			v1 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v1.AuxInt = boolToAuxInt(mulOverflows64(c, d))
+			v.AddArg2(v0, v1)
+			return true
+		}
+		break
+	}
+	// match: (Mul64over x (Const64 [1]))
+	// result: (MakeTuple x (ConstBool <typ.Bool> [false]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			x := v_0
+			if v_1.Op != OpConst64 || auxIntToInt64(v_1.AuxInt) != 1 {
+				continue
+			}
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v0.AuxInt = boolToAuxInt(false)
+			v.AddArg2(x, v0)
+			return true
+		}
+		break
+	}
+	// match: (Mul64over x z:(Const64 [0]))
+	// result: (MakeTuple z (ConstBool <typ.Bool> [false]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			z := v_1
+			if z.Op != OpConst64 || auxIntToInt64(z.AuxInt) != 0 {
+				continue
+			}
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v0.AuxInt = boolToAuxInt(false)
+			v.AddArg2(z, v0)
+			return true
+		}
+		break
+	}
+	return false
+}
 func rewriteValuegeneric_OpMul64uhilo(v *Value) bool {
 	v_1 := v.Args[1]
 	v_0 := v.Args[0]
@@ -19440,6 +19794,38 @@ func rewriteValuegeneric_OpMul64uover(v *Value) bool {
 		}
 		break
 	}
+	// match: (Mul64uover x (Const64 [1]))
+	// result: (MakeTuple x (ConstBool <typ.Bool> [false]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			x := v_0
+			if v_1.Op != OpConst64 || auxIntToInt64(v_1.AuxInt) != 1 {
+				continue
+			}
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v0.AuxInt = boolToAuxInt(false)
+			v.AddArg2(x, v0)
+			return true
+		}
+		break
+	}
+	// match: (Mul64uover x z:(Const64 [0]))
+	// result: (MakeTuple z (ConstBool <typ.Bool> [false]))
+	for {
+		for _i0 := 0; _i0 <= 1; _i0, v_0, v_1 = _i0+1, v_1, v_0 {
+			z := v_1
+			if z.Op != OpConst64 || auxIntToInt64(z.AuxInt) != 0 {
+				continue
+			}
+			v.reset(OpMakeTuple)
+			v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+			v0.AuxInt = boolToAuxInt(false)
+			v.AddArg2(z, v0)
+			return true
+		}
+		break
+	}
 	// match: (Mul64uover <t> (Const64 [1]) x)
 	// result: (MakeTuple x (ConstBool <t.FieldType(1)> [false]))
 	for {
@@ -34806,6 +35192,103 @@ func rewriteValuegeneric_OpSub32F(v *Value) bool {
 	}
 	return false
 }
+func rewriteValuegeneric_OpSub32over(v *Value) bool {
+	v_1 := v.Args[1]
+	v_0 := v.Args[0]
+	b := v.Block
+	typ := &b.Func.Config.Types
+	// match: (Sub32over (Const32 [c]) (Const32 [d]))
+	// result: (MakeTuple (Const32 <typ.Int32> [c-d]) (ConstBool <typ.Bool> [int64(c)-int64(d) != int64(c-d)]))
+	for {
+		if v_0.Op != OpConst32 {
+			break
+		}
+		c := auxIntToInt32(v_0.AuxInt)
+		if v_1.Op != OpConst32 {
+			break
+		}
+		d := auxIntToInt32(v_1.AuxInt)
+		v.reset(OpMakeTuple)
+		v0 := b.NewValue0(v.Pos, OpConst32, typ.Int32)
+		v0.AuxInt = int32ToAuxInt(c - d)
+		v1 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+		v1.AuxInt = boolToAuxInt(int64(c)-int64(d) != int64(c-d))
+		v.AddArg2(v0, v1)
+		return true
+	}
+	// match: (Sub32over x (Const32 [0]))
+	// result: (MakeTuple x (ConstBool <typ.Bool> [false]))
+	for {
+		x := v_0
+		if v_1.Op != OpConst32 || auxIntToInt32(v_1.AuxInt) != 0 {
+			break
+		}
+		v.reset(OpMakeTuple)
+		v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+		v0.AuxInt = boolToAuxInt(false)
+		v.AddArg2(x, v0)
+		return true
+	}
+	// match: (Sub32over x (Const32 <t> [c]))
+	// cond: x.Op != OpConst32 && c != math.MinInt32
+	// result: (Add32over (Const32 <t> [-c]) x)
+	for {
+		x := v_0
+		if v_1.Op != OpConst32 {
+			break
+		}
+		t := v_1.Type
+		c := auxIntToInt32(v_1.AuxInt)
+		if !(x.Op != OpConst32 && c != math.MinInt32) {
+			break
+		}
+		v.reset(OpAdd32over)
+		v0 := b.NewValue0(v.Pos, OpConst32, t)
+		v0.AuxInt = int32ToAuxInt(-c)
+		v.AddArg2(v0, x)
+		return true
+	}
+	return false
+}
+func rewriteValuegeneric_OpSub32uover(v *Value) bool {
+	v_1 := v.Args[1]
+	v_0 := v.Args[0]
+	b := v.Block
+	typ := &b.Func.Config.Types
+	// match: (Sub32uover (Const32 [c]) (Const32 [d]))
+	// result: (MakeTuple (Const32 <typ.UInt32> [c-d]) (ConstBool <typ.Bool> [uint32(c) < uint32(d)]))
+	for {
+		if v_0.Op != OpConst32 {
+			break
+		}
+		c := auxIntToInt32(v_0.AuxInt)
+		if v_1.Op != OpConst32 {
+			break
+		}
+		d := auxIntToInt32(v_1.AuxInt)
+		v.reset(OpMakeTuple)
+		v0 := b.NewValue0(v.Pos, OpConst32, typ.UInt32)
+		v0.AuxInt = int32ToAuxInt(c - d)
+		v1 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+		v1.AuxInt = boolToAuxInt(uint32(c) < uint32(d))
+		v.AddArg2(v0, v1)
+		return true
+	}
+	// match: (Sub32uover x (Const32 [0]))
+	// result: (MakeTuple x (ConstBool <typ.Bool> [false]))
+	for {
+		x := v_0
+		if v_1.Op != OpConst32 || auxIntToInt32(v_1.AuxInt) != 0 {
+			break
+		}
+		v.reset(OpMakeTuple)
+		v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+		v0.AuxInt = boolToAuxInt(false)
+		v.AddArg2(x, v0)
+		return true
+	}
+	return false
+}
 func rewriteValuegeneric_OpSub64(v *Value) bool {
 	v_1 := v.Args[1]
 	v_0 := v.Args[0]
@@ -35203,6 +35686,103 @@ func rewriteValuegeneric_OpSub64F(v *Value) bool {
 	}
 	return false
 }
+func rewriteValuegeneric_OpSub64over(v *Value) bool {
+	v_1 := v.Args[1]
+	v_0 := v.Args[0]
+	b := v.Block
+	typ := &b.Func.Config.Types
+	// match: (Sub64over (Const64 [c]) (Const64 [d]))
+	// result: (MakeTuple (Const64 <typ.Int64> [c-d]) (ConstBool <typ.Bool> [subOverflows64(c, d)]))
+	for {
+		if v_0.Op != OpConst64 {
+			break
+		}
+		c := auxIntToInt64(v_0.AuxInt)
+		if v_1.Op != OpConst64 {
+			break
+		}
+		d := auxIntToInt64(v_1.AuxInt)
+		v.reset(OpMakeTuple)
+		v0 := b.NewValue0(v.Pos, OpConst64, typ.Int64)
+		v0.AuxInt = int64ToAuxInt(c - d)
+		v1 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+		v1.AuxInt = boolToAuxInt(subOverflows64(c, d))
+		v.AddArg2(v0, v1)
+		return true
+	}
+	// match: (Sub64over x (Const64 [0]))
+	// result: (MakeTuple x (ConstBool <typ.Bool> [false]))
+	for {
+		x := v_0
+		if v_1.Op != OpConst64 || auxIntToInt64(v_1.AuxInt) != 0 {
+			break
+		}
+		v.reset(OpMakeTuple)
+		v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+		v0.AuxInt = boolToAuxInt(false)
+		v.AddArg2(x, v0)
+		return true
+	}
+	// match: (Sub64over x (Const64 <t> [c]))
+	// cond: x.Op != OpConst64 && c != math.MinInt64
+	// result: (Add64over (Const64 <t> [-c]) x)
+	for {
+		x := v_0
+		if v_1.Op != OpConst64 {
+			break
+		}
+		t := v_1.Type
+		c := auxIntToInt64(v_1.AuxInt)
+		if !(x.Op != OpConst64 && c != math.MinInt64) {
+			break
+		}
+		v.reset(OpAdd64over)
+		v0 := b.NewValue0(v.Pos, OpConst64, t)
+		v0.AuxInt = int64ToAuxInt(-c)
+		v.AddArg2(v0, x)
+		return true
+	}
+	return false
+}
+func rewriteValuegeneric_OpSub64uover(v *Value) bool {
+	v_1 := v.Args[1]
+	v_0 := v.Args[0]
+	b := v.Block
+	typ := &b.Func.Config.Types
+	// match: (Sub64uover (Const64 [c]) (Const64 [d]))
+	// result: (MakeTuple (Const64 <typ.UInt64> [c-d]) (ConstBool <typ.Bool> [uint64(c) < uint64(d)]))
+	for {
+		if v_0.Op != OpConst64 {
+			break
+		}
+		c := auxIntToInt64(v_0.AuxInt)
+		if v_1.Op != OpConst64 {
+			break
+		}
+		d := auxIntToInt64(v_1.AuxInt)
+		v.reset(OpMakeTuple)
+		v0 := b.NewValue0(v.Pos, OpConst64, typ.UInt64)
+		v0.AuxInt = int64ToAuxInt(c - d)
+		v1 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+		v1.AuxInt = boolToAuxInt(uint64(c) < uint64(d))
+		v.AddArg2(v0, v1)
+		return true
+	}
+	// match: (Sub64uover x (Const64 [0]))
+	// result: (MakeTuple x (ConstBool <typ.Bool> [false]))
+	for {
+		x := v_0
+		if v_1.Op != OpConst64 || auxIntToInt64(v_1.AuxInt) != 0 {
+			break
+		}
+		v.reset(OpMakeTuple)
+		v0 := b.NewValue0(v.Pos, OpConstBool, typ.Bool)
+		v0.AuxInt = boolToAuxInt(false)
+		v.AddArg2(x, v0)
+		return true
+	}
+	return false
+}
 func rewriteValuegeneric_OpSub8(v *Value) bool {
 	v_1 := v.Args[1]
 	v_0 := v.Args[0]
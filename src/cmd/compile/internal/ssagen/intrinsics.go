@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"internal/abi"
 	"internal/buildcfg"
+	"strings"
 
 	"cmd/compile/internal/base"
 	"cmd/compile/internal/ir"
@@ -1347,6 +1348,59 @@ func initIntrinsics(cfg *intrinsicBuildConfig) {
 	/******** math/big ********/
 	alias("math/big", "mulWW", "math/bits", "Mul64", p8...)
 
+	/******** math/arith ********/
+	// The functions of math/arith are generic. These intrinsics replace
+	// calls to their shaped instantiations (see findIntrinsic), whose
+	// first argument is the dictionary, so they use the last two.
+	for _, f := range [...]struct {
+		name string
+		op   ir.Op
+	}{{"Add", ir.OADD}, {"Sub", ir.OSUB}, {"Mul", ir.OMUL}} {
+		op := f.op
+		add("math/arith", "Wrapping"+f.name,
+			func(s *state, n *ir.CallExpr, args []*ssa.Value) *ssa.Value {
+				x, y := args[len(args)-2], args[len(args)-1]
+				return s.newValue2(s.ssaOp(op, x.Type), x.Type, x, y)
+			},
+			all...)
+		addF("math/arith", "Checked"+f.name,
+			func(s *state, n *ir.CallExpr, args []*ssa.Value) *ssa.Value {
+				x, y := args[len(args)-2], args[len(args)-1]
+				result, ok := s.fittingIntOp(op, x.Type, x, y)
+				return s.newValue2(ssa.OpMakeTuple, types.NewTuple(x.Type, types.Types[types.TBOOL]), result, ok)
+			},
+			sys.AMD64, sys.ARM64, sys.RISCV64)
+		addF("math/arith", "Saturating"+f.name,
+			func(s *state, n *ir.CallExpr, args []*ssa.Value) *ssa.Value {
+				x, y := args[len(args)-2], args[len(args)-1]
+				result, ok := s.fittingIntOp(op, x.Type, x, y)
+				return s.saturate(n, op, x, y, result, ok)
+			},
+			sys.AMD64, sys.ARM64, sys.RISCV64)
+	}
+
+	/******** runtime/panikint ********/
+	add("runtime/panikint", "enabledChecks",
+		func(s *state, n *ir.CallExpr, args []*ssa.Value) *ssa.Value {
+			// The classes are in the order of runtime/panikint.Check.
+			var checks int64
+			for i, on := range [...]bool{
+				base.Flag.OverflowDetect,
+				base.Flag.NegationDetect,
+				base.Flag.TruncationDetect,
+				base.Flag.ShiftDetect,
+				base.Flag.FloatConvDetect,
+				base.Flag.Int32Detect,
+				true, // range checks
+			} {
+				if on {
+					checks |= 1 << i
+				}
+			}
+			return s.constInt(types.Types[types.TUINT], checks)
+		},
+		all...)
+
 	/******** internal/runtime/maps ********/
 
 	// Important: The intrinsic implementations below return a packed
@@ -2274,10 +2328,18 @@ func findIntrinsic(sym *types.Sym) intrinsicBuilder {
 	}
 
 	fn := sym.Name
+	if pkg == "math/arith" {
+		// Look up the instantiations of the generic functions of
+		// math/arith, such as CheckedAdd[go.shape.int8], by the name
+		// of the function.
+		fn, _, _ = strings.Cut(fn, "[")
+	}
 	if ssa.IntrinsicsDisable {
 		if pkg == "internal/runtime/sys" && (fn == "GetCallerPC" || fn == "GetCallerSP" || fn == "GetClosurePtr") ||
 			pkg == simdPackage {
 			// These runtime functions don't have definitions, must be intrinsics.
+		} else if pkg == "runtime/panikint" {
+			// The definition of enabledChecks does not know the compiler flags.
 		} else {
 			return nil
 		}
@@ -2285,6 +2347,47 @@ func findIntrinsic(sym *types.Sym) intrinsicBuilder {
 	return intrinsics.lookup(Arch.LinkArch.Arch, pkg, fn)
 }
 
+// saturate returns result if ok, and otherwise the minimum or maximum
+// value of the type of x, whichever op on x and y overflowed past.
+func (s *state) saturate(n *ir.CallExpr, op ir.Op, x, y, result, ok *ssa.Value) *ssa.Value {
+	t := x.Type
+	s.vars[n] = result
+	b := s.endBlock()
+	b.Kind = ssa.BlockIf
+	b.SetControl(ok)
+	b.Likely = ssa.BranchLikely
+	bOverflow := s.f.NewBlock(ssa.BlockPlain)
+	bEnd := s.f.NewBlock(ssa.BlockPlain)
+	b.AddEdgeTo(bEnd)
+	b.AddEdgeTo(bOverflow)
+
+	s.startBlock(bOverflow)
+	switch {
+	case t.IsSigned():
+		// The maximum value, flipped to the minimum one if
+		// the result is negative, as the sign of sign tells.
+		sign := y
+		switch op {
+		case ir.OSUB:
+			sign = s.newValue1(s.ssaOp(ir.OBITNOT, t), t, y)
+		case ir.OMUL:
+			sign = s.newValue2(s.ssaOp(ir.OXOR, t), t, x, y)
+		}
+		u := types.Types[types.TUINT64]
+		sign = s.newValue2(s.ssaShiftOp(ir.ORSH, t, u), t, sign, s.constInt64(u, 8*t.Size()-1))
+		max := s.constIntOfSize(t, 1<<(8*t.Size()-1)-1)
+		s.vars[n] = s.newValue2(s.ssaOp(ir.OXOR, t), t, max, sign)
+	case op == ir.OSUB:
+		s.vars[n] = s.constIntOfSize(t, 0)
+	default:
+		s.vars[n] = s.constIntOfSize(t, -1)
+	}
+	s.endBlock().AddEdgeTo(bEnd)
+
+	s.startBlock(bEnd)
+	return s.variable(n, t)
+}
+
 func IsIntrinsicCall(n *ir.CallExpr) bool {
 	if n == nil {
 		return false
@@ -36,6 +36,9 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"386", "internal/runtime/sys", "TrailingZeros64"}:                 struct{}{},
 	{"386", "internal/runtime/sys", "TrailingZeros8"}:                  struct{}{},
 	{"386", "math", "sqrt"}:                                            struct{}{},
+	{"386", "math/arith", "WrappingAdd"}:                               struct{}{},
+	{"386", "math/arith", "WrappingMul"}:                               struct{}{},
+	{"386", "math/arith", "WrappingSub"}:                               struct{}{},
 	{"386", "math/bits", "Mul64"}:                                      struct{}{},
 	{"386", "math/bits", "ReverseBytes32"}:                             struct{}{},
 	{"386", "math/bits", "ReverseBytes64"}:                             struct{}{},
@@ -45,6 +48,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"386", "math/bits", "TrailingZeros8"}:                             struct{}{},
 	{"386", "runtime", "KeepAlive"}:                                    struct{}{},
 	{"386", "runtime", "slicebytetostringtmp"}:                         struct{}{},
+	{"386", "runtime/panikint", "enabledChecks"}:                       struct{}{},
 	{"386", "crypto/internal/constanttime", "boolToUint8"}:             struct{}{},
 	{"amd64", "internal/runtime/atomic", "And"}:                        struct{}{},
 	{"amd64", "internal/runtime/atomic", "And32"}:                      struct{}{},
@@ -122,6 +126,15 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"amd64", "math", "RoundToEven"}:                                   struct{}{},
 	{"amd64", "math", "Trunc"}:                                         struct{}{},
 	{"amd64", "math", "sqrt"}:                                          struct{}{},
+	{"amd64", "math/arith", "CheckedAdd"}:                              struct{}{},
+	{"amd64", "math/arith", "CheckedMul"}:                              struct{}{},
+	{"amd64", "math/arith", "CheckedSub"}:                              struct{}{},
+	{"amd64", "math/arith", "SaturatingAdd"}:                           struct{}{},
+	{"amd64", "math/arith", "SaturatingMul"}:                           struct{}{},
+	{"amd64", "math/arith", "SaturatingSub"}:                           struct{}{},
+	{"amd64", "math/arith", "WrappingAdd"}:                             struct{}{},
+	{"amd64", "math/arith", "WrappingMul"}:                             struct{}{},
+	{"amd64", "math/arith", "WrappingSub"}:                             struct{}{},
 	{"amd64", "math/big", "mulWW"}:                                     struct{}{},
 	{"amd64", "math/bits", "Add"}:                                      struct{}{},
 	{"amd64", "math/bits", "Add64"}:                                    struct{}{},
@@ -153,6 +166,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"amd64", "math/bits", "TrailingZeros8"}:                           struct{}{},
 	{"amd64", "runtime", "KeepAlive"}:                                  struct{}{},
 	{"amd64", "runtime", "slicebytetostringtmp"}:                       struct{}{},
+	{"amd64", "runtime/panikint", "enabledChecks"}:                     struct{}{},
 	{"amd64", "sync/atomic", "AddInt32"}:                               struct{}{},
 	{"amd64", "sync/atomic", "AddInt64"}:                               struct{}{},
 	{"amd64", "sync/atomic", "AddUint32"}:                              struct{}{},
@@ -204,6 +218,9 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"arm", "math", "Abs"}:                                             struct{}{},
 	{"arm", "math", "FMA"}:                                             struct{}{},
 	{"arm", "math", "sqrt"}:                                            struct{}{},
+	{"arm", "math/arith", "WrappingAdd"}:                               struct{}{},
+	{"arm", "math/arith", "WrappingMul"}:                               struct{}{},
+	{"arm", "math/arith", "WrappingSub"}:                               struct{}{},
 	{"arm", "math/bits", "Len"}:                                        struct{}{},
 	{"arm", "math/bits", "Len16"}:                                      struct{}{},
 	{"arm", "math/bits", "Len32"}:                                      struct{}{},
@@ -219,6 +236,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"arm", "math/bits", "TrailingZeros8"}:                             struct{}{},
 	{"arm", "runtime", "KeepAlive"}:                                    struct{}{},
 	{"arm", "runtime", "slicebytetostringtmp"}:                         struct{}{},
+	{"arm", "runtime/panikint", "enabledChecks"}:                       struct{}{},
 	{"arm", "crypto/internal/constanttime", "boolToUint8"}:             struct{}{},
 	{"arm64", "internal/runtime/atomic", "And"}:                        struct{}{},
 	{"arm64", "internal/runtime/atomic", "And32"}:                      struct{}{},
@@ -292,6 +310,15 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"arm64", "math", "RoundToEven"}:                                   struct{}{},
 	{"arm64", "math", "Trunc"}:                                         struct{}{},
 	{"arm64", "math", "sqrt"}:                                          struct{}{},
+	{"arm64", "math/arith", "CheckedAdd"}:                              struct{}{},
+	{"arm64", "math/arith", "CheckedMul"}:                              struct{}{},
+	{"arm64", "math/arith", "CheckedSub"}:                              struct{}{},
+	{"arm64", "math/arith", "SaturatingAdd"}:                           struct{}{},
+	{"arm64", "math/arith", "SaturatingMul"}:                           struct{}{},
+	{"arm64", "math/arith", "SaturatingSub"}:                           struct{}{},
+	{"arm64", "math/arith", "WrappingAdd"}:                             struct{}{},
+	{"arm64", "math/arith", "WrappingMul"}:                             struct{}{},
+	{"arm64", "math/arith", "WrappingSub"}:                             struct{}{},
 	{"arm64", "math/big", "mulWW"}:                                     struct{}{},
 	{"arm64", "math/bits", "Add"}:                                      struct{}{},
 	{"arm64", "math/bits", "Add64"}:                                    struct{}{},
@@ -326,6 +353,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"arm64", "runtime", "memequal"}:                                   struct{}{},
 	{"arm64", "runtime", "publicationBarrier"}:                         struct{}{},
 	{"arm64", "runtime", "slicebytetostringtmp"}:                       struct{}{},
+	{"arm64", "runtime/panikint", "enabledChecks"}:                     struct{}{},
 	{"arm64", "sync/atomic", "AddInt32"}:                               struct{}{},
 	{"arm64", "sync/atomic", "AddInt64"}:                               struct{}{},
 	{"arm64", "sync/atomic", "AddUint32"}:                              struct{}{},
@@ -436,6 +464,9 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"loong64", "math", "Floor"}:                                       struct{}{},
 	{"loong64", "math", "RoundToEven"}:                                 struct{}{},
 	{"loong64", "math", "Trunc"}:                                       struct{}{},
+	{"loong64", "math/arith", "WrappingAdd"}:                           struct{}{},
+	{"loong64", "math/arith", "WrappingMul"}:                           struct{}{},
+	{"loong64", "math/arith", "WrappingSub"}:                           struct{}{},
 	{"loong64", "math/big", "mulWW"}:                                   struct{}{},
 	{"loong64", "math/bits", "Add"}:                                    struct{}{},
 	{"loong64", "math/bits", "Add64"}:                                  struct{}{},
@@ -470,6 +501,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"loong64", "runtime", "KeepAlive"}:                                struct{}{},
 	{"loong64", "runtime", "publicationBarrier"}:                       struct{}{},
 	{"loong64", "runtime", "slicebytetostringtmp"}:                     struct{}{},
+	{"loong64", "runtime/panikint", "enabledChecks"}:                   struct{}{},
 	{"loong64", "sync/atomic", "AddInt32"}:                             struct{}{},
 	{"loong64", "sync/atomic", "AddInt64"}:                             struct{}{},
 	{"loong64", "sync/atomic", "AddUint32"}:                            struct{}{},
@@ -553,6 +585,9 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"mips", "internal/runtime/sys", "TrailingZeros8"}:                 struct{}{},
 	{"mips", "math", "Abs"}:                                            struct{}{},
 	{"mips", "math", "sqrt"}:                                           struct{}{},
+	{"mips", "math/arith", "WrappingAdd"}:                              struct{}{},
+	{"mips", "math/arith", "WrappingMul"}:                              struct{}{},
+	{"mips", "math/arith", "WrappingSub"}:                              struct{}{},
 	{"mips", "math/bits", "Len"}:                                       struct{}{},
 	{"mips", "math/bits", "Len16"}:                                     struct{}{},
 	{"mips", "math/bits", "Len32"}:                                     struct{}{},
@@ -566,6 +601,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"mips", "runtime", "KeepAlive"}:                                   struct{}{},
 	{"mips", "runtime", "publicationBarrier"}:                          struct{}{},
 	{"mips", "runtime", "slicebytetostringtmp"}:                        struct{}{},
+	{"mips", "runtime/panikint", "enabledChecks"}:                      struct{}{},
 	{"mips", "sync/atomic", "AddInt32"}:                                struct{}{},
 	{"mips", "sync/atomic", "AddUint32"}:                               struct{}{},
 	{"mips", "sync/atomic", "AddUintptr"}:                              struct{}{},
@@ -632,6 +668,9 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"mips64", "internal/runtime/sys", "GetClosurePtr"}:                struct{}{},
 	{"mips64", "math", "Abs"}:                                          struct{}{},
 	{"mips64", "math", "sqrt"}:                                         struct{}{},
+	{"mips64", "math/arith", "WrappingAdd"}:                            struct{}{},
+	{"mips64", "math/arith", "WrappingMul"}:                            struct{}{},
+	{"mips64", "math/arith", "WrappingSub"}:                            struct{}{},
 	{"mips64", "math/big", "mulWW"}:                                    struct{}{},
 	{"mips64", "math/bits", "Add"}:                                     struct{}{},
 	{"mips64", "math/bits", "Add64"}:                                   struct{}{},
@@ -642,6 +681,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"mips64", "runtime", "KeepAlive"}:                                 struct{}{},
 	{"mips64", "runtime", "publicationBarrier"}:                        struct{}{},
 	{"mips64", "runtime", "slicebytetostringtmp"}:                      struct{}{},
+	{"mips64", "runtime/panikint", "enabledChecks"}:                    struct{}{},
 	{"mips64", "sync/atomic", "AddInt32"}:                              struct{}{},
 	{"mips64", "sync/atomic", "AddInt64"}:                              struct{}{},
 	{"mips64", "sync/atomic", "AddUint32"}:                             struct{}{},
@@ -718,6 +758,9 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"mips64le", "internal/runtime/sys", "GetClosurePtr"}:              struct{}{},
 	{"mips64le", "math", "Abs"}:                                        struct{}{},
 	{"mips64le", "math", "sqrt"}:                                       struct{}{},
+	{"mips64le", "math/arith", "WrappingAdd"}:                          struct{}{},
+	{"mips64le", "math/arith", "WrappingMul"}:                          struct{}{},
+	{"mips64le", "math/arith", "WrappingSub"}:                          struct{}{},
 	{"mips64le", "math/big", "mulWW"}:                                  struct{}{},
 	{"mips64le", "math/bits", "Add"}:                                   struct{}{},
 	{"mips64le", "math/bits", "Add64"}:                                 struct{}{},
@@ -728,6 +771,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"mips64le", "runtime", "KeepAlive"}:                               struct{}{},
 	{"mips64le", "runtime", "publicationBarrier"}:                      struct{}{},
 	{"mips64le", "runtime", "slicebytetostringtmp"}:                    struct{}{},
+	{"mips64le", "runtime/panikint", "enabledChecks"}:                  struct{}{},
 	{"mips64le", "sync/atomic", "AddInt32"}:                            struct{}{},
 	{"mips64le", "sync/atomic", "AddInt64"}:                            struct{}{},
 	{"mips64le", "sync/atomic", "AddUint32"}:                           struct{}{},
@@ -795,6 +839,9 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"mipsle", "internal/runtime/sys", "TrailingZeros8"}:               struct{}{},
 	{"mipsle", "math", "Abs"}:                                          struct{}{},
 	{"mipsle", "math", "sqrt"}:                                         struct{}{},
+	{"mipsle", "math/arith", "WrappingAdd"}:                            struct{}{},
+	{"mipsle", "math/arith", "WrappingMul"}:                            struct{}{},
+	{"mipsle", "math/arith", "WrappingSub"}:                            struct{}{},
 	{"mipsle", "math/bits", "Len"}:                                     struct{}{},
 	{"mipsle", "math/bits", "Len16"}:                                   struct{}{},
 	{"mipsle", "math/bits", "Len32"}:                                   struct{}{},
@@ -808,6 +855,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"mipsle", "runtime", "KeepAlive"}:                                 struct{}{},
 	{"mipsle", "runtime", "publicationBarrier"}:                        struct{}{},
 	{"mipsle", "runtime", "slicebytetostringtmp"}:                      struct{}{},
+	{"mipsle", "runtime/panikint", "enabledChecks"}:                    struct{}{},
 	{"mipsle", "sync/atomic", "AddInt32"}:                              struct{}{},
 	{"mipsle", "sync/atomic", "AddUint32"}:                             struct{}{},
 	{"mipsle", "sync/atomic", "AddUintptr"}:                            struct{}{},
@@ -890,6 +938,9 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"ppc64", "math", "Round"}:                                         struct{}{},
 	{"ppc64", "math", "Trunc"}:                                         struct{}{},
 	{"ppc64", "math", "sqrt"}:                                          struct{}{},
+	{"ppc64", "math/arith", "WrappingAdd"}:                             struct{}{},
+	{"ppc64", "math/arith", "WrappingMul"}:                             struct{}{},
+	{"ppc64", "math/arith", "WrappingSub"}:                             struct{}{},
 	{"ppc64", "math/big", "mulWW"}:                                     struct{}{},
 	{"ppc64", "math/bits", "Add"}:                                      struct{}{},
 	{"ppc64", "math/bits", "Add64"}:                                    struct{}{},
@@ -920,6 +971,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"ppc64", "runtime", "KeepAlive"}:                                  struct{}{},
 	{"ppc64", "runtime", "publicationBarrier"}:                         struct{}{},
 	{"ppc64", "runtime", "slicebytetostringtmp"}:                       struct{}{},
+	{"ppc64", "runtime/panikint", "enabledChecks"}:                     struct{}{},
 	{"ppc64", "sync/atomic", "AddInt32"}:                               struct{}{},
 	{"ppc64", "sync/atomic", "AddInt64"}:                               struct{}{},
 	{"ppc64", "sync/atomic", "AddUint32"}:                              struct{}{},
@@ -1013,6 +1065,9 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"ppc64le", "math", "Round"}:                                       struct{}{},
 	{"ppc64le", "math", "Trunc"}:                                       struct{}{},
 	{"ppc64le", "math", "sqrt"}:                                        struct{}{},
+	{"ppc64le", "math/arith", "WrappingAdd"}:                           struct{}{},
+	{"ppc64le", "math/arith", "WrappingMul"}:                           struct{}{},
+	{"ppc64le", "math/arith", "WrappingSub"}:                           struct{}{},
 	{"ppc64le", "math/big", "mulWW"}:                                   struct{}{},
 	{"ppc64le", "math/bits", "Add"}:                                    struct{}{},
 	{"ppc64le", "math/bits", "Add64"}:                                  struct{}{},
@@ -1043,6 +1098,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"ppc64le", "runtime", "KeepAlive"}:                                struct{}{},
 	{"ppc64le", "runtime", "publicationBarrier"}:                       struct{}{},
 	{"ppc64le", "runtime", "slicebytetostringtmp"}:                     struct{}{},
+	{"ppc64le", "runtime/panikint", "enabledChecks"}:                   struct{}{},
 	{"ppc64le", "sync/atomic", "AddInt32"}:                             struct{}{},
 	{"ppc64le", "sync/atomic", "AddInt64"}:                             struct{}{},
 	{"ppc64le", "sync/atomic", "AddUint32"}:                            struct{}{},
@@ -1130,6 +1186,15 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"riscv64", "math", "Copysign"}:                                    struct{}{},
 	{"riscv64", "math", "FMA"}:                                         struct{}{},
 	{"riscv64", "math", "sqrt"}:                                        struct{}{},
+	{"riscv64", "math/arith", "CheckedAdd"}:                            struct{}{},
+	{"riscv64", "math/arith", "CheckedMul"}:                            struct{}{},
+	{"riscv64", "math/arith", "CheckedSub"}:                            struct{}{},
+	{"riscv64", "math/arith", "SaturatingAdd"}:                         struct{}{},
+	{"riscv64", "math/arith", "SaturatingMul"}:                         struct{}{},
+	{"riscv64", "math/arith", "SaturatingSub"}:                         struct{}{},
+	{"riscv64", "math/arith", "WrappingAdd"}:                           struct{}{},
+	{"riscv64", "math/arith", "WrappingMul"}:                           struct{}{},
+	{"riscv64", "math/arith", "WrappingSub"}:                           struct{}{},
 	{"riscv64", "math/big", "mulWW"}:                                   struct{}{},
 	{"riscv64", "math/bits", "Add"}:                                    struct{}{},
 	{"riscv64", "math/bits", "Add64"}:                                  struct{}{},
@@ -1162,6 +1227,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"riscv64", "runtime", "KeepAlive"}:                                struct{}{},
 	{"riscv64", "runtime", "publicationBarrier"}:                       struct{}{},
 	{"riscv64", "runtime", "slicebytetostringtmp"}:                     struct{}{},
+	{"riscv64", "runtime/panikint", "enabledChecks"}:                   struct{}{},
 	{"riscv64", "sync/atomic", "AddInt32"}:                             struct{}{},
 	{"riscv64", "sync/atomic", "AddInt64"}:                             struct{}{},
 	{"riscv64", "sync/atomic", "AddUint32"}:                            struct{}{},
@@ -1251,6 +1317,9 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"s390x", "math", "RoundToEven"}:                                   struct{}{},
 	{"s390x", "math", "Trunc"}:                                         struct{}{},
 	{"s390x", "math", "sqrt"}:                                          struct{}{},
+	{"s390x", "math/arith", "WrappingAdd"}:                             struct{}{},
+	{"s390x", "math/arith", "WrappingMul"}:                             struct{}{},
+	{"s390x", "math/arith", "WrappingSub"}:                             struct{}{},
 	{"s390x", "math/big", "mulWW"}:                                     struct{}{},
 	{"s390x", "math/bits", "Add"}:                                      struct{}{},
 	{"s390x", "math/bits", "Add64"}:                                    struct{}{},
@@ -1279,6 +1348,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"s390x", "math/bits", "TrailingZeros8"}:                           struct{}{},
 	{"s390x", "runtime", "KeepAlive"}:                                  struct{}{},
 	{"s390x", "runtime", "slicebytetostringtmp"}:                       struct{}{},
+	{"s390x", "runtime/panikint", "enabledChecks"}:                     struct{}{},
 	{"s390x", "sync/atomic", "AddInt32"}:                               struct{}{},
 	{"s390x", "sync/atomic", "AddInt64"}:                               struct{}{},
 	{"s390x", "sync/atomic", "AddUint32"}:                              struct{}{},
@@ -1322,6 +1392,9 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"wasm", "math", "RoundToEven"}:                                    struct{}{},
 	{"wasm", "math", "Trunc"}:                                          struct{}{},
 	{"wasm", "math", "sqrt"}:                                           struct{}{},
+	{"wasm", "math/arith", "WrappingAdd"}:                              struct{}{},
+	{"wasm", "math/arith", "WrappingMul"}:                              struct{}{},
+	{"wasm", "math/arith", "WrappingSub"}:                              struct{}{},
 	{"wasm", "math/big", "mulWW"}:                                      struct{}{},
 	{"wasm", "math/bits", "Len"}:                                       struct{}{},
 	{"wasm", "math/bits", "Len16"}:                                     struct{}{},
@@ -1344,6 +1417,7 @@ var wantIntrinsics = map[testIntrinsicKey]struct{}{
 	{"wasm", "math/bits", "TrailingZeros8"}:                            struct{}{},
 	{"wasm", "runtime", "KeepAlive"}:                                   struct{}{},
 	{"wasm", "runtime", "slicebytetostringtmp"}:                        struct{}{},
+	{"wasm", "runtime/panikint", "enabledChecks"}:                      struct{}{},
 	{"wasm", "crypto/internal/constanttime", "Select"}:                 struct{}{},
 	{"wasm", "crypto/internal/constanttime", "boolToUint8"}:            struct{}{},
 }
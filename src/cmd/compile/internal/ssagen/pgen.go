@@ -448,3 +448,55 @@ func CheckLargeStacks() {
 		}
 	}
 }
+
+// A failingArithCheck is an inserted check that always fails.
+type failingArithCheck struct {
+	pos src.XPos
+	msg string
+}
+
+var (
+	failingArithChecksMu sync.Mutex // protects failingArithChecks
+	failingArithChecks   []failingArithCheck
+)
+
+// CheckFailingArithChecks reports the checks that the optimizer proved
+// to always fail, such as the overflow check of x + x when x is the
+// constant 100 of type int8, along with the runtime error they report.
+// They are warnings, or errors with -panikint.strict.
+func CheckFailingArithChecks() {
+	report := base.WarnfAt
+	if base.Flag.PanikintStrict {
+		report = func(pos src.XPos, format string, args ...any) {
+			base.ErrorfAt(pos, 0, format, args...)
+		}
+	}
+	// Report each check once, where it is inlined the least: in its own
+	// function, or else at the call that makes it fail. The instantiations
+	// of a generic function share its checks too.
+	type check struct {
+		pos string
+		msg string
+	}
+	depth := func(pos src.XPos) (n int) {
+		base.Ctxt.AllPos(pos, func(src.Pos) { n++ })
+		return n
+	}
+	least := make(map[check]failingArithCheck)
+	for _, c := range failingArithChecks {
+		k := check{base.Ctxt.InnermostPos(c.pos).Format(true, true), c.msg}
+		if l, ok := least[k]; !ok || depth(c.pos) < depth(l.pos) {
+			least[k] = c
+		}
+	}
+	checks := make([]failingArithCheck, 0, len(least))
+	for _, c := range least {
+		checks = append(checks, c)
+	}
+	sort.Slice(checks, func(i, j int) bool {
+		return checks[i].pos.Before(checks[j].pos)
+	})
+	for _, c := range checks {
+		report(c.pos, "%s", c.msg)
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"internal/buildcfg"
 	"internal/goexperiment"
 	"internal/runtime/gc"
+	"math"
 	"os"
 	"path/filepath"
 	"slices"
@@ -24,6 +25,7 @@ import (
 	"cmd/compile/internal/base"
 	"cmd/compile/internal/ir"
 	"cmd/compile/internal/liveness"
+	"cmd/compile/internal/logopt"
 	"cmd/compile/internal/objw"
 	"cmd/compile/internal/reflectdata"
 	"cmd/compile/internal/rttype"
@@ -163,6 +165,18 @@ func InitConfig() {
 	ir.Syms.Panicoverflowdetailed = typecheck.LookupRuntimeFunc("panicoverflowdetailed")
 	ir.Syms.Panictruncate = typecheck.LookupRuntimeFunc("panictruncate")
 	ir.Syms.Panictruncatedetailed = typecheck.LookupRuntimeFunc("panictruncatedetailed")
+	ir.Syms.Panicfloatconvdetailed = typecheck.LookupRuntimeFunc("panicfloatconvdetailed")
+	ir.Syms.Panicarithnamed = typecheck.LookupRuntimeFunc("panicarithnamed")
+	ir.Syms.Panicfloatconvnamed = typecheck.LookupRuntimeFunc("panicfloatconvnamed")
+	ir.Syms.Panicrange = typecheck.LookupRuntimeFunc("panicrange")
+	ir.Syms.Panicrangefloat = typecheck.LookupRuntimeFunc("panicrangefloat")
+	ir.Syms.Reportoverflowdetailed = typecheck.LookupRuntimeFunc("reportoverflowdetailed")
+	ir.Syms.Reporttruncatedetailed = typecheck.LookupRuntimeFunc("reporttruncatedetailed")
+	ir.Syms.Reportfloatconvdetailed = typecheck.LookupRuntimeFunc("reportfloatconvdetailed")
+	ir.Syms.Reportarithnamed = typecheck.LookupRuntimeFunc("reportarithnamed")
+	ir.Syms.Reportfloatconvnamed = typecheck.LookupRuntimeFunc("reportfloatconvnamed")
+	ir.Syms.Reportrange = typecheck.LookupRuntimeFunc("reportrange")
+	ir.Syms.Reportrangefloat = typecheck.LookupRuntimeFunc("reportrangefloat")
 	ir.Syms.Panicshift = typecheck.LookupRuntimeFunc("panicshift")
 	ir.Syms.PanicSimdImm = typecheck.LookupRuntimeFunc("panicSimdImm")
 	ir.Syms.Racefuncenter = typecheck.LookupRuntimeFunc("racefuncenter")
@@ -375,6 +389,7 @@ func buildssa(fn *ir.Func, worker int, isPgoHot bool) *ssa.Func {
 	s.f.ABISelf = abiSelf
 
 	s.panics = map[funcLine]*ssa.Block{}
+	s.arithPanics = map[arithPanicKey]*ssa.Block{}
 	s.softFloat = s.config.SoftFloat
 
 	// Allocate starting block
@@ -1079,6 +1094,11 @@ type state struct {
 	// Used to deduplicate panic calls.
 	panics map[funcLine]*ssa.Block
 
+	// list of overflow and truncation panic calls by function name, line
+	// number, failed operation and reported values.
+	// Used to deduplicate identical checks without merging distinct ones.
+	arithPanics map[arithPanicKey]*ssa.Block
+
 	cgoUnsafeArgs       bool
 	hasdefer            bool // whether the function contains a defer statement
 	softFloat           bool
@@ -1136,6 +1156,12 @@ type funcLine struct {
 	line uint
 }
 
+type arithPanicKey struct {
+	funcLine
+	code int           // rtabi.ArithEncode of the operation, types and column
+	args [2]*ssa.Value // values passed to the panic call
+}
+
 type ssaLabel struct {
 	target         *ssa.Block // block identified by this label
 	breakTarget    *ssa.Block // block to break to in control flow node identified by this label
@@ -2841,7 +2867,7 @@ func (s *state) conv(n ir.Node, v *ssa.Value, ft, tt *types.Type) *ssa.Value {
 
 			// Check for same-size signed/unsigned conversion issues
 			if s.shouldCheckTruncation(n, ft, tt) {
-				return s.checkTypeTruncation(n, v, ft, tt, op)
+				return s.checkInt32(n, rtabi.ArithConv, s.checkTypeTruncation(n, v, ft, tt, op), ft, tt, v)
 			}
 		} else if tt.Size() < ft.Size() {
 			// truncation
@@ -2864,7 +2890,7 @@ func (s *state) conv(n ir.Node, v *ssa.Value, ft, tt *types.Type) *ssa.Value {
 
 			// Add truncation check if enabled
 			if s.shouldCheckTruncation(n, ft, tt) {
-				return s.checkTypeTruncation(n, v, ft, tt, op)
+				return s.checkInt32(n, rtabi.ArithConv, s.checkTypeTruncation(n, v, ft, tt, op), ft, tt, v)
 			}
 		} else if ft.IsSigned() {
 			// sign extension
@@ -2903,7 +2929,7 @@ func (s *state) conv(n ir.Node, v *ssa.Value, ft, tt *types.Type) *ssa.Value {
 				s.Fatalf("weird integer sign extension %v -> %v", ft, tt)
 			}
 		}
-		return s.newValue1(op, tt, v)
+		return s.checkInt32(n, rtabi.ArithConv, s.newValue1(op, tt, v), ft, tt, v)
 	}
 
 	if ft.IsComplex() && tt.IsComplex() {
@@ -2939,6 +2965,12 @@ func (s *state) conv(n ir.Node, v *ssa.Value, ft, tt *types.Type) *ssa.Value {
 	}
 
 	if ft.IsFloat() || tt.IsFloat() {
+		if ft.IsFloat() && tt.IsInteger() && s.shouldCheckFloatConversion(n, ft, tt) {
+			s.checkFloatConversion(v, ft, tt, 0)
+		}
+		if ft.IsFloat() && s.int32CheckWanted(n, rtabi.ArithConv, ft, tt) {
+			s.checkFloatConversion(v, ft, tt, rtabi.ArithInt32)
+		}
 		cft, ctt := s.concreteEtype(ft), s.concreteEtype(tt)
 		conv, ok := fpConvOpToSSA[twoTypes{cft, ctt}]
 		// there's a change to a conversion-op table, this restores the old behavior if ConvertHash is false.
@@ -3042,7 +3074,11 @@ func (s *state) conv(n ir.Node, v *ssa.Value, ft, tt *types.Type) *ssa.Value {
 
 // expr converts the expression n to ssa, adds it to s and returns the ssa result.
 func (s *state) expr(n ir.Node) *ssa.Value {
-	return s.exprCheckPtr(n, true)
+	v := s.exprCheckPtr(n, true)
+	if t := n.Type(); t != nil && t.Arith() != nil && t.Arith().Range {
+		v = s.checkRange(n, v)
+	}
+	return v
 }
 
 func (s *state) exprCheckPtr(n ir.Node, checkPtrOK bool) *ssa.Value {
@@ -3196,7 +3232,7 @@ func (s *state) exprCheckPtr(n ir.Node, checkPtrOK bool) *ssa.Value {
 
 		// named <--> unnamed type or typed <--> untyped const
 		if from.Kind() == to.Kind() {
-			return v
+			return s.checkRangeConv(n, x, v)
 		}
 
 		// unsafe.Pointer <--> *T
@@ -3237,12 +3273,12 @@ func (s *state) exprCheckPtr(n ir.Node, checkPtrOK bool) *ssa.Value {
 		}
 
 		// integer, same width, same sign
-		return v
+		return s.checkRangeConv(n, x, s.checkInt32(n, rtabi.ArithConv, v, from, to, x))
 
 	case ir.OCONV:
 		n := n.(*ir.ConvExpr)
 		x := s.expr(n.X)
-		return s.conv(n, x, n.X.Type(), n.Type())
+		return s.checkRangeConv(n, x, s.conv(n, x, n.X.Type(), n.Type()))
 
 	case ir.ODOTTYPE:
 		n := n.(*ir.TypeAssertExpr)
@@ -3327,7 +3363,7 @@ func (s *state) exprCheckPtr(n ir.Node, checkPtrOK bool) *ssa.Value {
 			return s.newValueOrSfCall2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
 		}
 
-		return s.intMul(n, a, b)
+		return s.checkInt32(n, arithOps[n.Op()], s.intMul(n, a, b), n.Type(), n.Type(), a, b)
 
 	case ir.ODIV:
 		n := n.(*ir.BinaryExpr)
@@ -3376,7 +3412,7 @@ func (s *state) exprCheckPtr(n ir.Node, checkPtrOK bool) *ssa.Value {
 		if n.Type().IsFloat() {
 			return s.newValueOrSfCall2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
 		}
-		return s.intDivide(n, a, b)
+		return s.checkInt32(n, arithOps[n.Op()], s.intDivide(n, a, b), n.Type(), n.Type(), a, b)
 	case ir.OMOD:
 		n := n.(*ir.BinaryExpr)
 		a := s.expr(n.X)
@@ -3396,11 +3432,13 @@ func (s *state) exprCheckPtr(n ir.Node, checkPtrOK bool) *ssa.Value {
 		if n.Type().IsFloat() {
 			return s.newValueOrSfCall2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
 		}
+		var r *ssa.Value
 		if n.Op() == ir.OADD {
-			return s.intAdd(n, a, b)
+			r = s.intAdd(n, a, b)
 		} else {
-			return s.intSub(n, a, b)
+			r = s.intSub(n, a, b)
 		}
+		return s.checkInt32(n, arithOps[n.Op()], r, n.Type(), n.Type(), a, b)
 	case ir.OAND, ir.OOR, ir.OXOR:
 		n := n.(*ir.BinaryExpr)
 		a := s.expr(n.X)
@@ -3422,6 +3460,9 @@ func (s *state) exprCheckPtr(n ir.Node, checkPtrOK bool) *ssa.Value {
 			s.check(cmp, ir.Syms.Panicshift)
 			bt = bt.ToUnsigned()
 		}
+		if n.Op() == ir.OLSH {
+			return s.checkInt32(n, rtabi.ArithShl, s.intShl(n, a, b, bt), n.Type(), n.Type(), a, b)
+		}
 		return s.newValue2(s.ssaShiftOp(n.Op(), n.Type(), bt), a.Type, a, b)
 	case ir.OANDAND, ir.OOROR:
 		// To implement OANDAND (and OOROR), we introduce a
@@ -3485,6 +3526,9 @@ func (s *state) exprCheckPtr(n ir.Node, checkPtrOK bool) *ssa.Value {
 				s.newValue1(negop, tp, s.newValue1(ssa.OpComplexReal, tp, a)),
 				s.newValue1(negop, tp, s.newValue1(ssa.OpComplexImag, tp, a)))
 		}
+		if n.Type().IsInteger() {
+			return s.checkInt32(n, rtabi.ArithNeg, s.intNeg(n, a), n.Type(), n.Type(), a, s.zeroVal(n.Type()))
+		}
 		return s.newValue1(s.ssaOp(n.Op(), n.Type()), a.Type, a)
 	case ir.ONOT, ir.OBITNOT:
 		n := n.(*ir.UnaryExpr)
@@ -5579,8 +5623,36 @@ func (s *state) check(cmp *ssa.Value, fn *obj.LSym) {
 	s.startBlock(bNext)
 }
 
-// If cmp (a bool) is false, panic using the given function with a custom message.
-func (s *state) checkWithMessage(cmp *ssa.Value, fn *obj.LSym, msg string) {
+// checkOverflow panics with the operands a and b of the arithmetic
+// expression n if cmp (a bool) is false.
+func (s *state) checkOverflow(cmp *ssa.Value, n ir.Node, a, b *ssa.Value) {
+	t := n.Type()
+	op, ok := arithOps[n.Op()]
+	if !ok {
+		s.Fatalf("unexpected overflow check of %v", n.Op())
+	}
+	s.checkWithValues(cmp, ir.Syms.Panicoverflowdetailed, ir.Syms.Reportoverflowdetailed, op, t, t, a, b)
+}
+
+// checkTruncation panics with the value v being converted from type from
+// to type to if cmp (a bool) is false.
+func (s *state) checkTruncation(cmp *ssa.Value, v *ssa.Value, from, to *types.Type) {
+	s.checkWithValues(cmp, ir.Syms.Panictruncatedetailed, ir.Syms.Reporttruncatedetailed, rtabi.ArithConv, from, to, v)
+}
+
+// If cmp (a bool) is false, panic using the function panicFn, passing it
+// the values args, each integer extended to 64 bits according to its own
+// type, followed by the encoding of op, src, dst and the current column.
+// The operands are of type src, except for the count of a shift.
+// Each check gets its own panic call, unless an identical check on the same
+// operation at the same position already has one.
+// With -arithrecover, reportFn is called with the same arguments instead
+// and execution continues after the check.
+// If src or dst is a defined type with //panikint: directives, the named
+// variant of panicFn or reportFn is called instead, so that the runtime
+// reports the name of the type. The range checks, whose op has the
+// arithRange flag, call panicFn or reportFn with the bounds of dst.
+func (s *state) checkWithValues(cmp *ssa.Value, panicFn, reportFn *obj.LSym, op rtabi.ArithOp, src, dst *types.Type, args ...*ssa.Value) {
 	b := s.endBlock()
 	b.Kind = ssa.BlockIf
 	b.SetControl(cmp)
@@ -5588,107 +5660,409 @@ func (s *state) checkWithMessage(cmp *ssa.Value, fn *obj.LSym, msg string) {
 	bNext := s.f.NewBlock(ssa.BlockPlain)
 	line := s.peekPos()
 	pos := base.Ctxt.PosTable.Pos(line)
-	fl := funcLine{f: fn, base: pos.Base(), line: pos.Line()}
-	bPanic := s.panics[fl]
+	code := rtabi.ArithEncode(op&^arithRange, arithKind(src), arithKind(dst), int(pos.Col()))
+	codeArg := len(args)
+	var names []*ssa.Value
+	if src.Arith() != nil || dst.Arith() != nil {
+		// The named variants take the names after the code, and the
+		// integer one takes two operands, like panicoverflowdetailed.
+		str := func(t *types.Type) *ssa.Value {
+			name := ""
+			if a := t.Arith(); a != nil {
+				name = a.Name
+			}
+			return s.entryNewValue0A(ssa.OpConstString, types.Types[types.TSTRING], ssa.StringToAux(name))
+		}
+		if op&arithRange != 0 {
+			// The range checks pass the bounds after the value.
+			if src.IsFloat() {
+				panicFn, reportFn = ir.Syms.Panicrangefloat, ir.Syms.Reportrangefloat
+			}
+			a, u64 := dst.Arith(), types.Types[types.TUINT64]
+			args = append(args, s.constInt64(u64, int64(a.Lo)), s.constInt64(u64, int64(a.Hi)))
+			names = []*ssa.Value{str(dst)}
+			codeArg = 3
+		} else if src.IsFloat() {
+			panicFn, reportFn = ir.Syms.Panicfloatconvnamed, ir.Syms.Reportfloatconvnamed
+			names = []*ssa.Value{str(dst)}
+		} else {
+			panicFn, reportFn = ir.Syms.Panicarithnamed, ir.Syms.Reportarithnamed
+			names = []*ssa.Value{str(src), str(dst)}
+			if len(args) == 1 {
+				args = append(args, s.constInt64(types.Types[types.TUINT64], 0))
+			}
+			codeArg = 2
+		}
+	}
+	fn := panicFn
+	if base.Flag.ArithRecover {
+		fn = reportFn
+	}
+	c := s.recordArithCheck(line, ssa.ArithCheckInserted, op, src, dst, fn)
+	c.Code, c.CodeArg = int64(code), codeArg
+	callArgs := func() []*ssa.Value {
+		callArgs := make([]*ssa.Value, 0, len(args)+1+len(names))
+		for _, a := range args {
+			if !a.Type.IsFloat() {
+				a = s.extendToUint64(a, a.Type)
+			}
+			callArgs = append(callArgs, a)
+		}
+		callArgs = append(callArgs, s.constInt(types.Types[types.TINT], int64(code)))
+		return append(callArgs, names...)
+	}
+
+	if base.Flag.ArithRecover {
+		// The report block returns to the check, so it cannot be shared.
+		bReport := s.f.NewBlock(ssa.BlockPlain)
+		b.AddEdgeTo(bNext)
+		b.AddEdgeTo(bReport)
+		s.startBlock(bReport)
+		s.rtcall(reportFn, true, nil, callArgs()...)
+		s.endBlock().AddEdgeTo(bNext)
+		s.startBlock(bNext)
+		return
+	}
+
+	key := arithPanicKey{funcLine: funcLine{f: panicFn, base: pos.Base(), line: pos.Line()}, code: code}
+	copy(key.args[:], args)
+	bPanic := s.arithPanics[key]
 	if bPanic == nil {
 		bPanic = s.f.NewBlock(ssa.BlockPlain)
-		s.panics[fl] = bPanic
+		s.arithPanics[key] = bPanic
 		s.startBlock(bPanic)
-		// Create string argument for detailed panic message
-		msgVal := s.entryNewValue0A(ssa.OpConstString, types.Types[types.TSTRING], ssa.StringToAux(msg))
 		// The panic call takes/returns memory to ensure that the right
 		// memory state is observed if the panic happens.
-		s.rtcall(fn, false, nil, msgVal)
+		s.rtcall(panicFn, false, nil, callArgs()...)
 	}
 	b.AddEdgeTo(bNext)
 	b.AddEdgeTo(bPanic)
 	s.startBlock(bNext)
 }
 
-// formatOverflowMessage creates a detailed overflow error message
-func formatOverflowMessage(op ir.Op, typ *types.Type) string {
-	var opStr string
-	switch op {
-	case ir.OADD:
-		opStr = "addition"
-	case ir.OSUB:
-		opStr = "subtraction"
-	case ir.OMUL:
-		opStr = "multiplication"
-	case ir.ODIV:
-		opStr = "division"
+// extendToUint64 sign or zero extends v of integer type t to 64 bits.
+func (s *state) extendToUint64(v *ssa.Value, t *types.Type) *ssa.Value {
+	u64 := types.Types[types.TUINT64]
+	var op ssa.Op
+	switch {
+	case t.Size() == 8:
+		return s.newValue1(ssa.OpCopy, u64, v)
+	case t.Size() == 1 && t.IsSigned():
+		op = ssa.OpSignExt8to64
+	case t.Size() == 1:
+		op = ssa.OpZeroExt8to64
+	case t.Size() == 2 && t.IsSigned():
+		op = ssa.OpSignExt16to64
+	case t.Size() == 2:
+		op = ssa.OpZeroExt16to64
+	case t.Size() == 4 && t.IsSigned():
+		op = ssa.OpSignExt32to64
+	case t.Size() == 4:
+		op = ssa.OpZeroExt32to64
 	default:
-		opStr = "arithmetic"
+		s.Fatalf("bad integer size %d for %v", t.Size(), t)
+	}
+	return s.newValue1(op, u64, v)
+}
+
+// arithOps maps the arithmetic operations checked for overflow to the
+// operation codes reported by the runtime.
+var arithOps = map[ir.Op]rtabi.ArithOp{
+	ir.OADD: rtabi.ArithAdd,
+	ir.OSUB: rtabi.ArithSub,
+	ir.OMUL: rtabi.ArithMul,
+	ir.ODIV: rtabi.ArithDiv,
+	ir.ONEG: rtabi.ArithNeg,
+	ir.OLSH: rtabi.ArithShl,
+}
+
+// rangeOps maps the other integer operations, whose results always fit
+// in their type but are checked against the range declared for it, to
+// the operation codes reported by the runtime.
+var rangeOps = map[ir.Op]rtabi.ArithOp{
+	ir.OMOD:    rtabi.ArithMod,
+	ir.ORSH:    rtabi.ArithShr,
+	ir.OAND:    rtabi.ArithAnd,
+	ir.OOR:     rtabi.ArithOr,
+	ir.OXOR:    rtabi.ArithXor,
+	ir.OANDNOT: rtabi.ArithAndNot,
+	ir.OBITNOT: rtabi.ArithNot,
+}
+
+// arithOpNames names the operations checked for overflow and truncation
+// as the runtime does in its error messages.
+var arithOpNames = [...]string{
+	rtabi.ArithAdd:  "addition",
+	rtabi.ArithSub:  "subtraction",
+	rtabi.ArithMul:  "multiplication",
+	rtabi.ArithDiv:  "division",
+	rtabi.ArithConv: "conversion",
+	rtabi.ArithNeg:  "negation",
+	rtabi.ArithShl:  "left shift",
+
+	rtabi.ArithMod:    "remainder",
+	rtabi.ArithShr:    "right shift",
+	rtabi.ArithAnd:    "bitwise and",
+	rtabi.ArithOr:     "bitwise or",
+	rtabi.ArithXor:    "bitwise xor",
+	rtabi.ArithAndNot: "bit clear",
+	rtabi.ArithNot:    "bitwise complement",
+}
+
+// recordArithCheck records the check of operation op at pos for the check
+// overflow pass, which reports it with -d=ssa/check_overflow/debug=1 and
+// -json. fn is the function called by an inserted check.
+func (s *state) recordArithCheck(pos src.XPos, status ssa.ArithCheckStatus, op rtabi.ArithOp, src, dst *types.Type, fn *obj.LSym) *ssa.ArithCheck {
+	class := "overflow"
+	switch {
+	case op&arithRange != 0:
+		class = "range"
+		op &^= arithRange
+	case op&rtabi.ArithInt32 != 0:
+		class = "int32"
+		op &^= rtabi.ArithInt32
+	case op == rtabi.ArithConv && src.IsFloat():
+		class = "floatconv"
+	case op == rtabi.ArithConv:
+		class = "truncation"
+	case op == rtabi.ArithNeg:
+		class = "negation"
+	case op == rtabi.ArithShl:
+		class = "shift"
+	}
+	s.f.ArithChecks = append(s.f.ArithChecks, ssa.ArithCheck{
+		Pos:    pos,
+		Status: status,
+		Class:  class,
+		Op:     arithOpNames[op],
+		Src:    src,
+		Dst:    dst,
+		Fn:     fn,
+	})
+	return &s.f.ArithChecks[len(s.f.ArithChecks)-1]
+}
+
+// arithCheckWanted reports whether the enabled check of operation op on n,
+// from type src to type dst, is to be inserted. Checks excluded from
+// instrumentation or suppressed by a directive are recorded as such.
+// The arithmetic whose result is of a type with a //panikint:wrapping
+// directive is never checked for overflow, but conversions to that type
+// are, and so are its range checks.
+func (s *state) arithCheckWanted(n ir.Node, op rtabi.ArithOp, src, dst *types.Type) bool {
+	conv := op&^(rtabi.ArithInt32|arithRange) == rtabi.ArithConv
+	switch {
+	case !instrumented(n.Pos(), conv && op&arithRange == 0 && src.IsInteger()):
+		s.recordArithCheck(n.Pos(), ssa.ArithCheckExcluded, op, src, dst, nil)
+		return false
+	case !conv && op&arithRange == 0 && dst.Arith() != nil && dst.Arith().Wrapping:
+		s.recordArithCheck(n.Pos(), ssa.ArithCheckSuppressed, op, src, dst, nil)
+		return false
+	case noArithCheck(n):
+		s.recordArithCheck(n.Pos(), ssa.ArithCheckSuppressed, op, src, dst, nil)
+		checks := ir.OverflowChecks
+		if conv {
+			checks = ir.TruncationChecks
+		}
+		useArithDirectives(n.Pos(), checks, false)
+		return false
 	}
+	return true
+}
 
-	var typeStr string
-	if typ.IsSigned() {
-		switch typ.Size() {
-		case 1:
-			typeStr = "int8"
-		case 2:
-			typeStr = "int16"
-		case 4:
-			typeStr = "int32"
-		case 8:
-			typeStr = "int64"
-		default:
-			typeStr = "signed integer"
+// overflowCheckWanted reports whether an overflow check is to be inserted
+// for the arithmetic n.
+func (s *state) overflowCheckWanted(n ir.Node) bool {
+	return s.shouldCheckOverflow(n) && s.arithCheckWanted(n, arithOps[n.Op()], n.Type(), n.Type())
+}
+
+// int32CheckWanted reports whether the operation op on n, from type src
+// to type dst, is to be checked by the 32-bit portability mode: whether
+// -int32detect is set, the check class of op is enabled, ints are 64
+// bits wide, dst is int or uint and, for conversions, some values of src
+// would not fit in dst on a 32-bit target.
+func (s *state) int32CheckWanted(n ir.Node, op rtabi.ArithOp, src, dst *types.Type) bool {
+	if !base.Flag.Int32Detect || n == nil || types.PtrSize != 8 {
+		return false
+	}
+	if k := dst.Kind(); k != types.TINT && k != types.TUINT {
+		return false
+	}
+	// A range declared for dst within 32 bits is checked instead.
+	if a := dst.Arith(); a != nil && a.Range {
+		if dst.IsSigned() && int64(a.Lo) >= math.MinInt32 && int64(a.Hi) <= math.MaxInt32 || !dst.IsSigned() && a.Hi <= math.MaxUint32 {
+			return false
 		}
-	} else {
-		switch typ.Size() {
-		case 1:
-			typeStr = "uint8"
-		case 2:
-			typeStr = "uint16"
-		case 4:
-			typeStr = "uint32"
-		case 8:
-			typeStr = "uint64"
-		default:
-			typeStr = "unsigned integer"
+	}
+	// A 32-bit target would wrap silently where the check of the
+	// operation itself is disabled.
+	enabled := base.Flag.OverflowDetect
+	switch {
+	case op == rtabi.ArithNeg:
+		enabled = base.Flag.NegationDetect
+	case op == rtabi.ArithShl:
+		enabled = base.Flag.ShiftDetect
+	case op == rtabi.ArithConv && src.IsFloat():
+		enabled = base.Flag.FloatConvDetect
+	case op == rtabi.ArithConv:
+		enabled = base.Flag.TruncationDetect
+	}
+	if !enabled {
+		return false
+	}
+	if op == rtabi.ArithConv && src.IsInteger() {
+		// The size of src on a 32-bit target.
+		size := src.Size()
+		switch src.Kind() {
+		case types.TINT, types.TUINT, types.TUINTPTR:
+			size = 4
 		}
+		switch {
+		case size == 8:
+		case !src.IsSigned() && (size < 4 || !dst.IsSigned()):
+			return false
+		case src.IsSigned() && dst.IsSigned():
+			return false
+		}
+	}
+	return s.arithCheckWanted(n, op|rtabi.ArithInt32, src, dst)
+}
+
+// checkInt32 panics if the int or uint value r, the result of the
+// operation op on args, from type src to type dst, does not fit in 32
+// bits, when the 32-bit portability mode wants the check. It returns r.
+func (s *state) checkInt32(n ir.Node, op rtabi.ArithOp, r *ssa.Value, src, dst *types.Type, args ...*ssa.Value) *ssa.Value {
+	if !s.int32CheckWanted(n, op, src, dst) {
+		return r
+	}
+	t32, ext := types.Types[types.TINT32], ssa.OpSignExt32to64
+	if !dst.IsSigned() {
+		t32, ext = types.Types[types.TUINT32], ssa.OpZeroExt32to64
 	}
+	back := s.newValue1(ext, dst, s.newValue1(ssa.OpTrunc64to32, t32, r))
+	fits := s.newValue2(ssa.OpEq64, types.Types[types.TBOOL], back, r)
 
-	return fmt.Sprintf("integer overflow in %s %s operation", typeStr, opStr)
+	panicFn, reportFn := ir.Syms.Panicoverflowdetailed, ir.Syms.Reportoverflowdetailed
+	if op == rtabi.ArithConv {
+		panicFn, reportFn = ir.Syms.Panictruncatedetailed, ir.Syms.Reporttruncatedetailed
+	}
+	s.checkWithValues(fits, panicFn, reportFn, op|rtabi.ArithInt32, src, dst, args...)
+	return r
 }
 
-// formatTruncationMessage creates a detailed truncation error message
-func formatTruncationMessage(srcType, dstType *types.Type) string {
-	srcStr := getTypeString(srcType)
-	dstStr := getTypeString(dstType)
-	return fmt.Sprintf("integer truncation: %s cannot fit in %s", srcStr, dstStr)
+// arithRange is set in the operation of the range checks of the types
+// with a //panikint:range directive. Unlike rtabi.ArithInt32, it is not
+// encoded: the runtime tells range checks by the function they call.
+const arithRange rtabi.ArithOp = 1 << 7
+
+// checkRange panics if v, the result of the arithmetic n, lies outside
+// the range declared for the type of n by a //panikint:range directive.
+// Range checks are not part of a check class: the directive enables
+// them. It returns v.
+func (s *state) checkRange(n ir.Node, v *ssa.Value) *ssa.Value {
+	t := n.Type()
+	op, ok := arithOps[n.Op()]
+	if !ok {
+		op, ok = rangeOps[n.Op()]
+	}
+	if !ok || !s.arithCheckWanted(n, op|arithRange, t, t) {
+		return v
+	}
+	s.pushLine(n.Pos())
+	defer s.popLine()
+	s.checkWithValues(s.inRange(v, t), ir.Syms.Panicrange, ir.Syms.Reportrange, op|arithRange, t, t, v)
+	return v
 }
 
-// getTypeString returns a string representation of an integer type
-func getTypeString(typ *types.Type) string {
-	if typ.IsSigned() {
-		switch typ.Size() {
-		case 1:
-			return "int8"
-		case 2:
-			return "int16"
-		case 4:
-			return "int32"
-		case 8:
-			return "int64"
-		default:
-			return "signed integer"
-		}
+// checkRangeConv panics if x, the operand of the conversion n, lies
+// outside the range declared for the type of n by a //panikint:range
+// directive, like checkRange. v is the result of the conversion, which
+// it returns.
+func (s *state) checkRangeConv(n *ir.ConvExpr, x, v *ssa.Value) *ssa.Value {
+	t, src := n.Type(), n.X.Type()
+	if !isRangeConv(n, src, t) || !src.IsInteger() && !src.IsFloat() {
+		return v
+	}
+	if !s.arithCheckWanted(n, rtabi.ArithConv|arithRange, src, t) {
+		return v
+	}
+	s.pushLine(n.Pos())
+	defer s.popLine()
+
+	// x is in range if the conversion keeps its value, as the truncation
+	// and float conversion checks tell, and v is. So a negative x is out
+	// of the range of an unsigned type, whatever v wraps to.
+	var fits *ssa.Value
+	if src.IsFloat() {
+		fits, x = s.floatConversionFits(x, src, t, 0)
 	} else {
-		switch typ.Size() {
-		case 1:
-			return "uint8"
-		case 2:
-			return "uint16"
-		case 4:
-			return "uint32"
-		case 8:
-			return "uint64"
-		default:
-			return "unsigned integer"
-		}
+		fits = s.conversionFits(x, v, src, t)
 	}
+	inRange := s.inRange(v, t)
+	if fits != nil {
+		inRange = s.newValue2(ssa.OpAndB, types.Types[types.TBOOL], fits, inRange)
+	}
+	s.checkWithValues(inRange, ir.Syms.Panicrange, ir.Syms.Reportrange, rtabi.ArithConv|arithRange, src, t, x)
+	return v
+}
+
+// isRangeConv reports whether n converts a value of type from to the type
+// to with a //panikint:range directive. The range check of the conversion
+// compares its operand with bounds that lie within to, so it takes the
+// place of the truncation and float conversion checks. The conversions
+// between a shape and the type it stands for have nothing to check.
+func isRangeConv(n ir.Node, from, to *types.Type) bool {
+	a := to.Arith()
+	return n != nil && a != nil && a.Range && from.Arith() != a
+}
+
+// inRange returns a bool that is true if the integer v of type t lies
+// within the range declared for t.
+func (s *state) inRange(v *ssa.Value, t *types.Type) *ssa.Value {
+	// The bounds are compared with v extended to 64 bits, as the
+	// operands of a truncation check are.
+	a, bt, u64 := t.Arith(), types.Types[types.TBOOL], types.Types[types.TUINT64]
+	le := ssa.OpLeq64U
+	if t.IsSigned() {
+		le = ssa.OpLeq64
+	}
+	x := s.extendToUint64(v, t)
+	aboveLo := s.newValue2(le, bt, s.constInt64(u64, int64(a.Lo)), x)
+	belowHi := s.newValue2(le, bt, x, s.constInt64(u64, int64(a.Hi)))
+	return s.newValue2(ssa.OpAndB, bt, aboveLo, belowHi)
+}
+
+// arithKind returns the runtime kind of the integer type t.
+func arithKind(t *types.Type) rtabi.Kind {
+	switch t.Kind() {
+	case types.TINT:
+		return rtabi.Int
+	case types.TINT8:
+		return rtabi.Int8
+	case types.TINT16:
+		return rtabi.Int16
+	case types.TINT32:
+		return rtabi.Int32
+	case types.TINT64:
+		return rtabi.Int64
+	case types.TUINT:
+		return rtabi.Uint
+	case types.TUINT8:
+		return rtabi.Uint8
+	case types.TUINT16:
+		return rtabi.Uint16
+	case types.TUINT32:
+		return rtabi.Uint32
+	case types.TUINT64:
+		return rtabi.Uint64
+	case types.TUINTPTR:
+		return rtabi.Uintptr
+	case types.TFLOAT32:
+		return rtabi.Float32
+	case types.TFLOAT64:
+		return rtabi.Float64
+	}
+	base.Fatalf("unexpected arithmetic check of type %v", t)
+	return rtabi.Invalid
 }
 
 func (s *state) intDivide(n ir.Node, a, b *ssa.Value) *ssa.Value {
@@ -5716,7 +6090,7 @@ func (s *state) intDivide(n ir.Node, a, b *ssa.Value) *ssa.Value {
 }
 
 // isStandardLibraryPackage returns true if the package path represents a Go standard library
-// or internal package that should be excluded from overflow detection.
+// or internal package that should be excluded from overflow detection by default.
 func isStandardLibraryPackage(pkgPath string) bool {
 	// Standard library and internal packages to exclude
 	if pkgPath == "" ||
@@ -5739,7 +6113,7 @@ func isStandardLibraryPackage(pkgPath string) bool {
 }
 
 // isStandardLibraryFile returns true if the filename represents a Go standard library
-// or internal file that should be excluded from overflow detection.
+// or internal file that should be excluded from overflow detection by default.
 func isStandardLibraryFile(filename string) bool {
 	if filename == "" {
 		return false
@@ -5775,176 +6149,201 @@ func isStandardLibraryFile(filename string) bool {
 	return false
 }
 
-// Suppression directives to skip integer overflow checks on specific source lines.
-// If a line contains one of these markers, or the immediately preceding line contains it,
-// arithmetic overflow checks for that line will be skipped.
-const (
-	// Match either with or without a leading '@' in comments
-	overflowSuppressionDirectivePrimary = "overflow_false_positive"
-)
-
-// cache mapping filename -> set of line numbers that contain the suppression directive
-var overflowSuppressionCache sync.Map // map[string]map[int]struct{}
+// isEncodingBinaryFile reports whether filename belongs to a copy of
+// encoding/binary, whose integer conversions truncate on purpose. Their
+// truncation checks are excluded from instrumentation by default.
+func isEncodingBinaryFile(filename string) bool {
+	return strings.Contains(filename, "/encoding/binary/")
+}
 
-// hasOverflowSuppression reports whether the given position has a nearby suppression directive.
-// It returns true if the exact line or the immediately preceding line contains the directive.
-func hasOverflowSuppression(pos src.XPos) bool {
-	if !pos.IsKnown() {
-		return false
-	}
-	p := base.Ctxt.PosTable.Pos(pos)
-	filename := p.Filename()
-	intLine := int(p.Line())
-	if filename == "" || intLine <= 0 {
+// instrumented reports whether the arithmetic at pos is checked, or its
+// integer conversion if truncation is set. Code is excluded if it matches
+// -panikint.exclude, and otherwise included if it matches
+// -panikint.include. Failing both, the standard library and third-party
+// dependencies are excluded by isStandardLibraryPackage and
+// isStandardLibraryFile, and integer conversions by isEncodingBinaryFile.
+// Code inlined from another package is matched against the patterns as
+// part of that package.
+func instrumented(pos src.XPos, truncation bool) bool {
+	if base.Flag.CompilingRuntime {
+		// The runtime cannot call its own panic functions everywhere.
 		return false
 	}
-
-	// Load or populate the per-file directive line set.
-	var lineSet map[int]struct{}
-	if v, ok := overflowSuppressionCache.Load(filename); ok {
-		lineSet, _ = v.(map[int]struct{})
-	} else {
-		// Build the set once per file.
-		data, err := os.ReadFile(filename)
-		if err != nil {
-			return false
-		}
-		lines := strings.Split(string(data), "\n")
-		set := make(map[int]struct{}, 8)
-		for i, s := range lines {
-			if strings.Contains(s, overflowSuppressionDirectivePrimary) {
-				// Lines are 1-indexed in src.Pos
-				set[i+1] = struct{}{}
+	pkg := base.Ctxt.Pkgpath
+	var filename string
+	if pos.IsKnown() {
+		p := base.Ctxt.PosTable.Pos(pos)
+		filename = p.Filename()
+		if i := p.Base().InliningIndex(); i >= 0 {
+			if path, err := objabi.PrefixToPath(base.Ctxt.InlTree.InlinedFuncPkg(i)); err == nil && path != "" {
+				pkg = path
 			}
 		}
-		overflowSuppressionCache.Store(filename, set)
-		lineSet = set
 	}
-
-	if lineSet == nil {
+	if base.Flag.Cfg.ArithExclude.Match(pkg, filename) {
 		return false
 	}
-	if _, ok := lineSet[intLine]; ok {
+	if base.Flag.Cfg.ArithInclude.Match(pkg, filename) {
 		return true
 	}
-	if intLine > 1 {
-		if _, ok := lineSet[intLine-1]; ok {
-			return true
-		}
+	if truncation && isEncodingBinaryFile(filename) {
+		return false
 	}
-	return false
+	return !isStandardLibraryPackage(base.Ctxt.Pkgpath) && !isStandardLibraryFile(filename)
 }
 
-// Suppress truncation detection when a directive is present on the same or previous line.
-const (
-	// Match either with or without a leading '@' in comments
-	truncationSuppressionDirectivePrimary = "truncation_false_positive"
-)
+// noArithCheck reports whether the overflow or truncation check on n
+// was suppressed by a //panikint:ignore or //go:nooverflowcheck
+// directive, or turned off by the compiler for the arithmetic it
+// generates itself.
+func noArithCheck(n ir.Node) bool {
+	x, ok := n.(interface{ NoArithCheck() bool })
+	return ok && x.NoArithCheck()
+}
 
-var truncationSuppressionCache sync.Map // map[string]map[int]struct{}
+// arithDirectivesMu guards the Used and Disabled fields of the
+// directives in typecheck.Target.ArithDirectives, which are set as
+// functions are compiled, possibly concurrently.
+var arithDirectivesMu sync.Mutex
 
-func hasTruncationSuppression(pos src.XPos) bool {
-	if !pos.IsKnown() {
-		return false
-	}
+// useArithDirectives records that the check on the operation at pos was
+// suppressed by the directives that cover it, or would have been if its
+// class were enabled. checks is the kind of the check.
+func useArithDirectives(pos src.XPos, checks ir.ArithChecks, disabled bool) {
 	p := base.Ctxt.PosTable.Pos(pos)
-	filename := p.Filename()
-	intLine := int(p.Line())
-	if filename == "" || intLine <= 0 {
-		return false
-	}
-
-	var lineSet map[int]struct{}
-	if v, ok := truncationSuppressionCache.Load(filename); ok {
-		lineSet, _ = v.(map[int]struct{})
-	} else {
-		data, err := os.ReadFile(filename)
-		if err != nil {
-			return false
+	arithDirectivesMu.Lock()
+	defer arithDirectivesMu.Unlock()
+	for _, d := range typecheck.Target.ArithDirectives {
+		if d.Checks&checks == 0 || !d.Covers(p) {
+			continue
 		}
-		lines := strings.Split(string(data), "\n")
-		set := make(map[int]struct{}, 8)
-		for i, s := range lines {
-			if strings.Contains(s, truncationSuppressionDirectivePrimary) {
-				set[i+1] = struct{}{}
-			}
+		if disabled {
+			d.Disabled |= checks
+		} else {
+			d.Used |= checks
 		}
-		truncationSuppressionCache.Store(filename, set)
-		lineSet = set
 	}
+}
 
-	if lineSet == nil {
-		return false
+// arithCheckDisabled records that the check of kind checks on n is not
+// inserted because its class is disabled, so that the directives
+// suppressing it are reported as stale. It returns false.
+func arithCheckDisabled(n ir.Node, checks ir.ArithChecks) bool {
+	if n != nil && noArithCheck(n) {
+		useArithDirectives(n.Pos(), checks, true)
 	}
-	if _, ok := lineSet[intLine]; ok {
-		return true
+	return false
+}
+
+// CheckArithDirectives reports the //panikint:ignore and
+// //go:nooverflowcheck directives of the package that suppress no
+// checks, because the code they applied to changed or because the
+// checks are disabled. They are warnings, or errors with
+// -panikint.strict. Directives in code excluded from instrumentation
+// by default are not reported.
+func CheckArithDirectives() {
+	if base.Flag.CompilingRuntime {
+		return
 	}
-	if intLine > 1 {
-		if _, ok := lineSet[intLine-1]; ok {
-			return true
+	report := base.WarnfAt
+	if base.Flag.PanikintStrict {
+		report = func(pos src.XPos, format string, args ...any) {
+			base.ErrorfAt(pos, 0, format, args...)
+		}
+	}
+	pkg := base.Ctxt.Pkgpath
+	for _, d := range typecheck.Target.ArithDirectives {
+		if logopt.Enabled() {
+			logArithDirective(d)
+		}
+		filename := base.Ctxt.PosTable.Pos(d.Pos).Filename()
+		included := false
+		switch {
+		case base.Flag.Cfg.ArithExclude.Match(pkg, filename):
+			report(d.Pos, "stale //%s directive: file is excluded by -panikint.exclude", d.Name)
+			continue
+		case base.Flag.Cfg.ArithInclude.Match(pkg, filename):
+			included = true
+		case isStandardLibraryPackage(pkg) || isStandardLibraryFile(filename):
+			continue
+		}
+		for _, c := range [...]ir.ArithChecks{ir.OverflowChecks, ir.TruncationChecks} {
+			class := "overflow"
+			if c == ir.TruncationChecks {
+				class = "truncation"
+			}
+			switch {
+			case d.Checks&c == 0 || d.Used&c != 0:
+			case c == ir.TruncationChecks && !included && isEncodingBinaryFile(filename):
+			case d.Disabled&c != 0:
+				report(d.Pos, "stale //%s directive: %s checks are disabled", d.Name, class)
+			case !d.Generic:
+				report(d.Pos, "stale //%s directive: no %s checks to suppress", d.Name, class)
+			}
 		}
 	}
-	return false
 }
 
-// shouldCheckOverflow returns true if overflow detection should be applied for this operation.
-// It checks if the package should be excluded from overflow detection and if the type is supported.
-func (s *state) shouldCheckOverflow(typ *types.Type) bool {
-	if os.Getenv("GOPANIKINT_DISABLE_OVERFLOW") != "" {
-		return false
+// logArithDirective logs the directive d to the optimizer log, with
+// the directive as message and the source ranges it applies to, or the
+// directive itself if it applies to the whole file, as related
+// information. go tool panikint uses it to attribute the suppressed
+// checks to their directives.
+func logArithDirective(d *ir.ArithDirective) {
+	text := "//" + d.Name
+	if d.Name == "panikint:ignore" {
+		if d.Checks&ir.OverflowChecks != 0 {
+			text += " overflow"
+		}
+		if d.Checks&ir.TruncationChecks != 0 {
+			text += " truncation"
+		}
 	}
+	var scopes []*logopt.LoggedOpt
+	if d.File {
+		scopes = append(scopes, logopt.NewLoggedOpt(d.Pos, d.Pos, "file", "panikint", ""))
+	}
+	for _, r := range d.Ranges {
+		start, end := base.Ctxt.PosTable.XPos(r.Start), base.Ctxt.PosTable.XPos(r.End)
+		scopes = append(scopes, logopt.NewLoggedOpt(start, end, "scope", "panikint", ""))
+	}
+	logopt.LogOpt(d.Pos, "arithDirective", "panikint", "", text, scopes)
+}
 
-	// Apply overflow detection based on source location, not compilation context
-	// The key insight is that when user code calls library functions, those functions
-	// may get inlined, but the source positions still point to the original files.
-
-	// Check current package path - this helps during the initial toolchain build
-	pkgPath := base.Ctxt.Pkgpath
-
-	// During toolchain build, exclude standard library and internal packages
-	if isStandardLibraryPackage(pkgPath) {
-		return false
+// shouldCheckOverflow returns true if overflow detection should be applied for the operation n.
+// It checks if the check class of n is enabled and if the type is supported.
+func (s *state) shouldCheckOverflow(n ir.Node) bool {
+	var enabled bool
+	switch n.Op() {
+	case ir.ONEG:
+		enabled = base.Flag.NegationDetect
+	case ir.OLSH:
+		enabled = base.Flag.ShiftDetect
+	default:
+		enabled = base.Flag.OverflowDetect
 	}
 
-	// Check overflow for signed (int8, int16, int32) and unsigned (uint8, uint16, uint32, uint64) integers
-	// Exclude int64 and uintptr due to complexity and platform dependencies
-	if typ.IsInteger() {
-		if typ.IsSigned() {
-			switch typ.Size() {
-			case 1, 2, 4: // int8, int16, int32
-				return true
-			}
-		} else {
-			// Include unsigned types: uint8, uint16, uint32, uint64
-			switch typ.Size() {
-			case 1, 2, 4, 8: // uint8, uint16, uint32, uint64
-				return true
-			}
+	// Check overflow for all fixed-size signed (int8 ... int64, int) and unsigned
+	// (uint8 ... uint64, uint) integers. uintptr is checked too unless disabled
+	// with -overflowuintptr=false, since pointer arithmetic often wraps on purpose.
+	if typ := n.Type(); typ.IsInteger() {
+		if typ.Kind() == types.TUINTPTR && !base.Flag.OverflowUintptr {
+			enabled = false
+		}
+		switch typ.Size() {
+		case 1, 2, 4, 8:
+			return enabled || arithCheckDisabled(n, ir.OverflowChecks)
 		}
 	}
 	return false
 }
 
 // shouldCheckTruncation returns true if truncation detection should be applied for this conversion.
-// It checks if the package should be excluded from truncation detection and if the conversion is potentially lossy.
+// It checks if truncation detection is enabled and if the conversion is potentially lossy.
 func (s *state) shouldCheckTruncation(n ir.Node, fromType, toType *types.Type) bool {
-	// Check if truncation detection is enabled via flag
-	if !base.Flag.TruncationDetect {
+	if isRangeConv(n, fromType, toType) {
 		return false
 	}
-
-	// Apply truncation detection based on source location, not compilation context
-	// The key insight is that when user code calls library functions, those functions
-	// may get inlined, but the source positions still point to the original files.
-
-	// Check current package path - this helps during the initial toolchain build
-	pkgPath := base.Ctxt.Pkgpath
-
-	// During toolchain build, exclude standard library and internal packages
-	if isStandardLibraryPackage(pkgPath) {
-		return false
-	}
-
 	// Check truncation for integer types in these cases:
 	// 1. Target type is smaller than source type (traditional truncation)
 	// 2. Same size but different signedness (problematic conversions)
@@ -5953,18 +6352,75 @@ func (s *state) shouldCheckTruncation(n ir.Node, fromType, toType *types.Type) b
 		// But exclude uintptr as it's platform-dependent and often used for low-level operations
 		if fromType.Kind() != types.TUINTPTR && toType.Kind() != types.TUINTPTR {
 			// Case 1: Traditional truncation (target smaller than source)
-			if fromType.Size() > toType.Size() {
-				return true
-			}
 			// Case 2: Same size but different signedness (can cause unexpected values)
-			if fromType.Size() == toType.Size() && fromType.IsSigned() != toType.IsSigned() {
-				return true
+			if fromType.Size() > toType.Size() ||
+				fromType.Size() == toType.Size() && fromType.IsSigned() != toType.IsSigned() {
+				// Check if truncation detection is enabled via flag
+				return base.Flag.TruncationDetect || arithCheckDisabled(n, ir.TruncationChecks)
 			}
 		}
 	}
 	return false
 }
 
+// shouldCheckFloatConversion reports whether the conversion n of a float
+// to the integer type toType should be checked for NaN, infinite and
+// out-of-range values.
+func (s *state) shouldCheckFloatConversion(n ir.Node, fromType, toType *types.Type) bool {
+	if n == nil || !toType.IsInteger() || isRangeConv(n, fromType, toType) {
+		return false
+	}
+	if !base.Flag.FloatConvDetect {
+		return arithCheckDisabled(n, ir.TruncationChecks)
+	}
+	return s.arithCheckWanted(n, rtabi.ArithConv, fromType, toType)
+}
+
+// checkFloatConversion panics with the float value v of type fromType if
+// it is NaN or if its integer part does not fit in the integer type toType.
+// With the flag rtabi.ArithInt32, toType is int or uint and the check is
+// against its 32-bit range.
+func (s *state) checkFloatConversion(v *ssa.Value, fromType, toType *types.Type, flag rtabi.ArithOp) {
+	inRange, v := s.floatConversionFits(v, fromType, toType, flag)
+	s.checkWithValues(inRange, ir.Syms.Panicfloatconvdetailed, ir.Syms.Reportfloatconvdetailed, rtabi.ArithConv|flag, fromType, toType, v)
+}
+
+// floatConversionFits returns a bool that is true if the float value v of
+// type fromType is not NaN and its integer part fits in the integer type
+// toType, with the flag as for checkFloatConversion, and v as a float64.
+func (s *state) floatConversionFits(v *ssa.Value, fromType, toType *types.Type, flag rtabi.ArithOp) (fits, v64 *ssa.Value) {
+	f64 := types.Types[types.TFLOAT64]
+	if fromType.Size() == 4 {
+		// Every float32 is exactly representable as a float64.
+		v = s.newValueOrSfCall1(ssa.OpCvt32Fto64F, f64, v)
+	}
+
+	// The conversion truncates towards zero, so v fits if lo < v < hi,
+	// where lo and hi are one below the minimum and one above the maximum
+	// of toType. The minimum of a 64-bit signed type less one rounds to the
+	// minimum itself, which is therefore compared inclusively instead.
+	bits := int(8 * toType.Size())
+	if flag&rtabi.ArithInt32 != 0 {
+		bits = 32
+	}
+	lo, hi := -1.0, math.Ldexp(1, bits)
+	lowOp := ssa.OpLess64F
+	if toType.IsSigned() {
+		hi = math.Ldexp(1, bits-1)
+		lo = -hi - 1
+		if bits == 64 {
+			lo = -hi
+			lowOp = ssa.OpLeq64F
+		}
+	}
+
+	// Both comparisons are false for NaN.
+	bt := types.Types[types.TBOOL]
+	aboveLo := s.newValueOrSfCall2(lowOp, bt, s.constFloat64(f64, lo), v)
+	belowHi := s.newValueOrSfCall2(ssa.OpLess64F, bt, v, s.constFloat64(f64, hi))
+	return s.newValue2(ssa.OpAndB, bt, aboveLo, belowHi), v
+}
+
 // checkTypeTruncation generates runtime checks to detect truncation during type conversion
 func (s *state) checkTypeTruncation(n ir.Node, value *ssa.Value, fromType, toType *types.Type, op ssa.Op) *ssa.Value {
 	// When Node is nil (like in s.conv(nil, load, loadType, lenType)),
@@ -5985,123 +6441,80 @@ func (s *state) checkTypeTruncation(n ir.Node, value *ssa.Value, fromType, toTyp
 
 	// Check source location of this specific conversion operation
 	// This is crucial for distinguishing user code from standard library code
-	pos := n.Pos()
-	if pos.IsKnown() {
-		filename := base.Ctxt.PosTable.Pos(pos).Filename()
-		if isStandardLibraryFile(filename) {
-			// Skip truncation detection for operations from standard library files
-			return s.newValue1(op, toType, value)
-		}
-
-		// Additional specific check for encoding/binary
-		if strings.Contains(filename, "/encoding/binary/") {
-			return s.newValue1(op, toType, value)
-		}
-	}
-
-	// Allow source-level suppression using the same directive key.
-	if hasTruncationSuppression(n.Pos()) {
+	if !s.arithCheckWanted(n, rtabi.ArithConv, fromType, toType) {
 		return s.newValue1(op, toType, value)
 	}
 
 	// Perform the conversion first
 	result := s.newValue1(op, toType, value)
 
-	// Check if truncation/conversion issue occurred by comparing with range bounds
-	var maxVal, minVal int64
-
-	// Get the maximum and minimum values for the target type
-	switch toType.Size() {
-	case 1: // int8/uint8
-		if toType.IsSigned() {
-			maxVal = 127
-			minVal = -128
-		} else {
-			maxVal = 255
-			minVal = 0
-		}
-	case 2: // int16/uint16
-		if toType.IsSigned() {
-			maxVal = 32767
-			minVal = -32768
-		} else {
-			maxVal = 65535
-			minVal = 0
-		}
-	case 4: // int32/uint32
-		if toType.IsSigned() {
-			maxVal = 2147483647
-			minVal = -2147483648
-		} else {
-			maxVal = 4294967295
-			minVal = 0
-		}
-	default:
-		// For 8-byte types, no truncation check needed yet
-		return result
+	fits := s.conversionFits(value, result, fromType, toType)
+	if fits == nil {
+		s.Fatalf("no truncation check for %v -> %v", fromType, toType)
 	}
 
-	// Create constants with the correct type for comparison
-	maxConst := s.newValue0I(ssa.OpConst64, types.Types[types.TINT64], maxVal)
-	minConst := s.newValue0I(ssa.OpConst64, types.Types[types.TINT64], minVal)
+	// s.checkTruncation() panics when condition is FALSE, so pass the "no truncation" condition
+	s.checkTruncation(fits, value, fromType, toType)
 
-	// Convert value to int64 for comparison if needed
-	compareValue := value
-	if fromType.Size() < 8 {
-		if fromType.IsSigned() {
-			// Sign extend smaller signed types to int64
-			switch fromType.Size() {
-			case 1:
-				compareValue = s.newValue1(ssa.OpSignExt8to64, types.Types[types.TINT64], value)
-			case 2:
-				compareValue = s.newValue1(ssa.OpSignExt16to64, types.Types[types.TINT64], value)
-			case 4:
-				compareValue = s.newValue1(ssa.OpSignExt32to64, types.Types[types.TINT64], value)
-			}
+	return result
+}
+
+// conversionFits returns a bool that is true if the conversion of the
+// integer value of type from to result of type to keeps the value, or nil
+// if every value of from fits in to.
+func (s *state) conversionFits(value, result *ssa.Value, from, to *types.Type) *ssa.Value {
+	// The conversion keeps the value if the result, extended back to 64 bits,
+	// equals the extended operand and, when the signedness changes, the sign
+	// bit of the operand is clear. Values that differ only in their sign bit
+	// extend to the same 64 bits, so the sign-bit test is what catches
+	// same-size conversions and those from 64-bit unsigned to signed types.
+	// Unsigned values always fit in wider signed types.
+	bt := types.Types[types.TBOOL]
+	var fits *ssa.Value
+	if to.Size() < from.Size() {
+		fits = s.newValue2(ssa.OpEq64, bt, s.extendToUint64(result, to), s.extendToUint64(value, from))
+	}
+	if from.IsSigned() != to.IsSigned() && (from.IsSigned() || to.Size() <= from.Size()) {
+		nonNeg := s.signBitClear(value, from)
+		if fits == nil {
+			fits = nonNeg
 		} else {
-			// Zero extend smaller unsigned types to int64
-			switch fromType.Size() {
-			case 1:
-				compareValue = s.newValue1(ssa.OpZeroExt8to64, types.Types[types.TINT64], value)
-			case 2:
-				compareValue = s.newValue1(ssa.OpZeroExt16to64, types.Types[types.TINT64], value)
-			case 4:
-				compareValue = s.newValue1(ssa.OpZeroExt32to64, types.Types[types.TINT64], value)
-			}
-		}
+			fits = s.newValue2(ssa.OpAndB, bt, fits, nonNeg)
+		}
+	}
+	return fits
+}
+
+// signBitClear returns a bool that is true if the highest bit of the
+// integer v of type t is clear, that is if v is not negative when its
+// bits are read as a signed integer of the same size.
+func (s *state) signBitClear(v *ssa.Value, t *types.Type) *ssa.Value {
+	var st *types.Type
+	switch t.Size() {
+	case 1:
+		st = types.Types[types.TINT8]
+	case 2:
+		st = types.Types[types.TINT16]
+	case 4:
+		st = types.Types[types.TINT32]
+	case 8:
+		st = types.Types[types.TINT64]
+	default:
+		s.Fatalf("bad integer size %d for %v", t.Size(), t)
 	}
-
-	// Check if value is within bounds: minVal <= value <= maxVal
-	geMin := s.newValue2(ssa.OpLeq64, types.Types[types.TBOOL], minConst, compareValue)
-	leMax := s.newValue2(ssa.OpLeq64, types.Types[types.TBOOL], compareValue, maxConst)
-	inBounds := s.newValue2(ssa.OpAndB, types.Types[types.TBOOL], geMin, leMax)
-
-	// s.checkWithMessage() panics when condition is FALSE, so pass the "no truncation" condition
-	errorMsg := formatTruncationMessage(fromType, toType)
-	s.checkWithMessage(inBounds, ir.Syms.Panictruncatedetailed, errorMsg)
-
-	return result
+	return s.newValue2(s.ssaOp(ir.OLE, st), types.Types[types.TBOOL], s.zeroVal(st), v)
 }
 
 // intAdd performs addition with overflow detection for signed and unsigned integers
 func (s *state) intAdd(n ir.Node, a, b *ssa.Value) *ssa.Value {
-	// Check source location of this specific arithmetic operation
-	// This is crucial for distinguishing user code from standard library code
-	pos := n.Pos()
-	if pos.IsKnown() {
-		filename := base.Ctxt.PosTable.Pos(pos).Filename()
-		if isStandardLibraryFile(filename) {
-			// Skip overflow detection for operations from standard library files
-			return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
-		}
-	}
-
-	if hasOverflowSuppression(n.Pos()) {
+	// Skip overflow detection for disabled checks and for operations
+	// excluded from instrumentation or suppressed by a directive
+	if !s.overflowCheckWanted(n) {
 		return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
 	}
 
-	if !s.shouldCheckOverflow(n.Type()) {
-		return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
+	if result, ok := s.checkedIntOp(n, a, b); ok {
+		return result
 	}
 
 	result := s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
@@ -6138,10 +6551,9 @@ func (s *state) intAdd(n ir.Node, a, b *ssa.Value) *ssa.Value {
 		// Overall overflow condition
 		overflow := s.newValue2(ssa.OpOrB, types.Types[types.TBOOL], posOverflow, negOverflow)
 
-		// s.checkWithMessage() panics when condition is FALSE, so pass "no overflow" condition
+		// s.checkOverflow() panics when condition is FALSE, so pass "no overflow" condition
 		noOverflow := s.newValue1(ssa.OpNot, types.Types[types.TBOOL], overflow)
-		errorMsg := formatOverflowMessage(n.Op(), n.Type())
-		s.checkWithMessage(noOverflow, ir.Syms.Panicoverflowdetailed, errorMsg)
+		s.checkOverflow(noOverflow, n, a, b)
 	} else {
 		// Unsigned integer overflow detection:
 		// For addition a + b, overflow occurs when result < a (or result < b)
@@ -6150,10 +6562,9 @@ func (s *state) intAdd(n ir.Node, a, b *ssa.Value) *ssa.Value {
 		// Check if result < a (overflow condition)
 		resultLtA := s.newValue2(s.ssaOp(ir.OLT, result.Type), types.Types[types.TBOOL], result, a)
 
-		// s.checkWithMessage() panics when condition is FALSE, so pass "no overflow" condition
+		// s.checkOverflow() panics when condition is FALSE, so pass "no overflow" condition
 		noOverflow := s.newValue1(ssa.OpNot, types.Types[types.TBOOL], resultLtA)
-		errorMsg := formatOverflowMessage(n.Op(), n.Type())
-		s.checkWithMessage(noOverflow, ir.Syms.Panicoverflowdetailed, errorMsg)
+		s.checkOverflow(noOverflow, n, a, b)
 	}
 
 	return result
@@ -6161,22 +6572,14 @@ func (s *state) intAdd(n ir.Node, a, b *ssa.Value) *ssa.Value {
 
 // intSub performs subtraction with overflow detection for signed and unsigned integers
 func (s *state) intSub(n ir.Node, a, b *ssa.Value) *ssa.Value {
-	// Check source location of this specific arithmetic operation
-	pos := n.Pos()
-	if pos.IsKnown() {
-		filename := base.Ctxt.PosTable.Pos(pos).Filename()
-		if isStandardLibraryFile(filename) {
-			// Skip overflow detection for operations from standard library files
-			return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
-		}
-	}
-
-	if hasOverflowSuppression(n.Pos()) {
+	// Skip overflow detection for disabled checks and for operations
+	// excluded from instrumentation or suppressed by a directive
+	if !s.overflowCheckWanted(n) {
 		return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
 	}
 
-	if !s.shouldCheckOverflow(n.Type()) {
-		return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
+	if result, ok := s.checkedIntOp(n, a, b); ok {
+		return result
 	}
 
 	result := s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
@@ -6213,10 +6616,9 @@ func (s *state) intSub(n ir.Node, a, b *ssa.Value) *ssa.Value {
 		// Overall overflow condition
 		overflow := s.newValue2(ssa.OpOrB, types.Types[types.TBOOL], posOverflow, negOverflow)
 
-		// s.checkWithMessage() panics when condition is FALSE, so pass "no overflow" condition
+		// s.checkOverflow() panics when condition is FALSE, so pass "no overflow" condition
 		noOverflow := s.newValue1(ssa.OpNot, types.Types[types.TBOOL], overflow)
-		errorMsg := formatOverflowMessage(n.Op(), n.Type())
-		s.checkWithMessage(noOverflow, ir.Syms.Panicoverflowdetailed, errorMsg)
+		s.checkOverflow(noOverflow, n, a, b)
 	} else {
 		// Unsigned integer underflow detection:
 		// For subtraction a - b, underflow occurs when a < b
@@ -6225,35 +6627,79 @@ func (s *state) intSub(n ir.Node, a, b *ssa.Value) *ssa.Value {
 		// Check if a < b (underflow condition)
 		aLtB := s.newValue2(s.ssaOp(ir.OLT, a.Type), types.Types[types.TBOOL], a, b)
 
-		// s.checkWithMessage() panics when condition is FALSE, so pass "no underflow" condition
+		// s.checkOverflow() panics when condition is FALSE, so pass "no underflow" condition
 		noUnderflow := s.newValue1(ssa.OpNot, types.Types[types.TBOOL], aLtB)
-		errorMsg := formatOverflowMessage(n.Op(), n.Type())
-		s.checkWithMessage(noUnderflow, ir.Syms.Panicoverflowdetailed, errorMsg)
+		s.checkOverflow(noUnderflow, n, a, b)
 	}
 
 	return result
 }
 
-// intMul performs multiplication with overflow detection for signed and unsigned integers
-func (s *state) intMul(n ir.Node, a, b *ssa.Value) *ssa.Value {
-	// Check source location of this specific arithmetic operation
-	pos := n.Pos()
-	if pos.IsKnown() {
-		filename := base.Ctxt.PosTable.Pos(pos).Filename()
-		if isStandardLibraryFile(filename) {
-			// Skip overflow detection for operations from standard library files
-			return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
-		}
+// intNeg performs negation with overflow detection for signed and unsigned integers
+func (s *state) intNeg(n ir.Node, a *ssa.Value) *ssa.Value {
+	result := s.newValue1(s.ssaOp(n.Op(), n.Type()), a.Type, a)
+
+	// Skip overflow detection for disabled checks and for operations
+	// excluded from instrumentation or suppressed by a directive
+	if !s.overflowCheckWanted(n) {
+		return result
 	}
 
-	if hasOverflowSuppression(n.Pos()) {
-		return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
+	zero := s.zeroVal(n.Type())
+	var ok *ssa.Value
+	if n.Type().IsSigned() {
+		// Signed integer overflow detection for negation -a:
+		// only the minimum value, whose negation is itself, overflows.
+		// That is, a < 0 and result < 0.
+		aLtZero := s.newValue2(s.ssaOp(ir.OLT, a.Type), types.Types[types.TBOOL], a, zero)
+		resultLtZero := s.newValue2(s.ssaOp(ir.OLT, result.Type), types.Types[types.TBOOL], result, zero)
+		overflow := s.newValue2(ssa.OpAndB, types.Types[types.TBOOL], aLtZero, resultLtZero)
+		ok = s.newValue1(ssa.OpNot, types.Types[types.TBOOL], overflow)
+	} else {
+		// Unsigned integer underflow detection:
+		// the negation of any value other than zero is negative.
+		ok = s.newValue2(s.ssaOp(ir.OEQ, a.Type), types.Types[types.TBOOL], a, zero)
 	}
 
-	if !s.shouldCheckOverflow(n.Type()) {
+	// s.checkOverflow() panics when condition is FALSE, so pass the "no overflow" condition.
+	// Negation has a single operand; zero fills in for the second.
+	s.checkOverflow(ok, n, a, zero)
+	return result
+}
+
+// intShl performs a left shift of a by the unsigned count b of type bt.
+// With -shiftdetect, it panics if the shift loses set bits or changes the sign.
+func (s *state) intShl(n ir.Node, a, b *ssa.Value, bt *types.Type) *ssa.Value {
+	result := s.newValue2(s.ssaShiftOp(ir.OLSH, n.Type(), bt), a.Type, a, b)
+	// Skip shift detection for disabled checks and for operations
+	// excluded from instrumentation or suppressed by a directive
+	if !s.overflowCheckWanted(n) {
+		return result
+	}
+
+	// The shift is lossless if shifting the result back by the same count
+	// gives a again. For signed integers the shift back is arithmetic, so
+	// a change of the sign bit is caught too.
+	back := s.newValue2(s.ssaShiftOp(ir.ORSH, n.Type(), bt), a.Type, result, b)
+	lossless := s.newValue2(s.ssaOp(ir.OEQ, a.Type), types.Types[types.TBOOL], back, a)
+
+	// s.checkOverflow() panics when condition is FALSE, so pass the "lossless" condition
+	s.checkOverflow(lossless, n, a, b)
+	return result
+}
+
+// intMul performs multiplication with overflow detection for signed and unsigned integers
+func (s *state) intMul(n ir.Node, a, b *ssa.Value) *ssa.Value {
+	// Skip overflow detection for disabled checks and for operations
+	// excluded from instrumentation or suppressed by a directive
+	if !s.overflowCheckWanted(n) {
 		return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
 	}
 
+	if result, ok := s.checkedIntOp(n, a, b); ok {
+		return result
+	}
+
 	// Multiplication overflow detection for both signed and unsigned integers:
 	// Check if result/a != b (when a != 0) or result/b != a (when b != 0)
 	// This works for both signed and unsigned integers
@@ -6268,8 +6714,6 @@ func (s *state) intMul(n ir.Node, a, b *ssa.Value) *ssa.Value {
 	// Either operand is zero - no overflow possible
 	eitherZero := s.newValue2(ssa.OpOrB, types.Types[types.TBOOL], aIsZero, bIsZero)
 
-	errorMsg := formatOverflowMessage(n.Op(), n.Type())
-
 	// Skip the division-based check when either operand is zero to avoid divide-by-zero traps.
 	b0 := s.endBlock()
 	b0.Kind = ssa.BlockIf
@@ -6284,8 +6728,16 @@ func (s *state) intMul(n ir.Node, a, b *ssa.Value) *ssa.Value {
 	// For non-zero operands, check if result/a == b.
 	quotientA := s.newValue2(s.ssaOp(ir.ODIV, n.Type()), a.Type, result, a)
 	quotientAEqB := s.newValue2(s.ssaOp(ir.OEQ, quotientA.Type), types.Types[types.TBOOL], quotientA, b)
-	// s.checkWithMessage() panics when condition is FALSE, so pass the valid condition.
-	s.checkWithMessage(quotientAEqB, ir.Syms.Panicoverflowdetailed, errorMsg)
+	if n.Type().IsSigned() {
+		// -1 * MIN_INT wraps back to MIN_INT, and MIN_INT / -1 is MIN_INT again,
+		// so the quotient test alone cannot see it.
+		minTimesNegOne := s.newValue2(ssa.OpOrB, types.Types[types.TBOOL],
+			s.isMinIntAndNegOne(n.Type(), a, b), s.isMinIntAndNegOne(n.Type(), b, a))
+		quotientAEqB = s.newValue2(ssa.OpAndB, types.Types[types.TBOOL], quotientAEqB,
+			s.newValue1(ssa.OpNot, types.Types[types.TBOOL], minTimesNegOne))
+	}
+	// s.checkOverflow() panics when condition is FALSE, so pass the valid condition.
+	s.checkOverflow(quotientAEqB, n, a, b)
 	s.endBlock().AddEdgeTo(bAfter)
 
 	s.startBlock(bZero)
@@ -6300,28 +6752,6 @@ func (s *state) intMul(n ir.Node, a, b *ssa.Value) *ssa.Value {
 // The specific case we're checking for is MIN_INT / -1 which causes overflow
 // because MIN_INT = -2^(n-1) and -MIN_INT = 2^(n-1) which exceeds MAX_INT = 2^(n-1) - 1
 func (s *state) intDiv(n ir.Node, a, b *ssa.Value) *ssa.Value {
-	// Check source location of this specific arithmetic operation
-	pos := n.Pos()
-	if pos.IsKnown() {
-		filename := base.Ctxt.PosTable.Pos(pos).Filename()
-		if isStandardLibraryFile(filename) {
-			// Skip overflow detection for operations from standard library files
-			// Still do division by zero check for safety
-			needcheck := true
-			switch b.Op {
-			case ssa.OpConst8, ssa.OpConst16, ssa.OpConst32, ssa.OpConst64:
-				if b.AuxInt != 0 {
-					needcheck = false
-				}
-			}
-			if needcheck {
-				cmp := s.newValue2(s.ssaOp(ir.ONE, n.Type()), types.Types[types.TBOOL], b, s.zeroVal(n.Type()))
-				s.check(cmp, ir.Syms.Panicdivide)
-			}
-			return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
-		}
-	}
-
 	// First check for division by zero (same as intDivide function)
 	needcheck := true
 	switch b.Op {
@@ -6336,11 +6766,13 @@ func (s *state) intDiv(n ir.Node, a, b *ssa.Value) *ssa.Value {
 		s.check(cmp, ir.Syms.Panicdivide)
 	}
 
-	// If overflow detection is suppressed/disabled, just perform the division
-	if hasOverflowSuppression(n.Pos()) {
+	// Unsigned division cannot overflow
+	if !n.Type().IsSigned() {
 		return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
 	}
-	if !s.shouldCheckOverflow(n.Type()) {
+
+	// If overflow detection is disabled, excluded or suppressed, just perform the division
+	if !s.overflowCheckWanted(n) {
 		return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
 	}
 
@@ -6349,43 +6781,133 @@ func (s *state) intDiv(n ir.Node, a, b *ssa.Value) *ssa.Value {
 	// For int8: -128 / -1 = 128 (but max int8 is 127)
 	// For int16: -32768 / -1 = 32768 (but max int16 is 32767)
 	// For int32: -2147483648 / -1 = 2147483648 (but max int32 is 2147483647)
+	// For int64: -9223372036854775808 / -1 = 9223372036854775808 (but max int64 is 9223372036854775807)
+	overflow := s.isMinIntAndNegOne(n.Type(), a, b)
 
-	// Create constants for MIN_INT and -1 based on the type
-	var minInt int64
-	switch n.Type().Size() {
-	case 1: // int8
-		minInt = -128
-	case 2: // int16
-		minInt = -32768
-	case 4: // int32
-		minInt = -2147483648
-	default:
-		// For other sizes, don't apply overflow detection
-		return s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
-	}
-
-	minIntConst := s.constInt(n.Type(), minInt)
-	negOneConst := s.constInt(n.Type(), -1)
-
-	// Check if a == MIN_INT
-	aIsMinInt := s.newValue2(s.ssaOp(ir.OEQ, n.Type()), types.Types[types.TBOOL], a, minIntConst)
-
-	// Check if b == -1
-	bIsNegOne := s.newValue2(s.ssaOp(ir.OEQ, n.Type()), types.Types[types.TBOOL], b, negOneConst)
-
-	// Overflow occurs when both conditions are true
-	overflow := s.newValue2(ssa.OpAndB, types.Types[types.TBOOL], aIsMinInt, bIsNegOne)
-
-	// s.checkWithMessage() panics when condition is FALSE, so pass "no overflow" condition
+	// s.checkOverflow() panics when condition is FALSE, so pass "no overflow" condition
 	noOverflow := s.newValue1(ssa.OpNot, types.Types[types.TBOOL], overflow)
-	errorMsg := formatOverflowMessage(n.Op(), n.Type())
-	s.checkWithMessage(noOverflow, ir.Syms.Panicoverflowdetailed, errorMsg)
+	s.checkOverflow(noOverflow, n, a, b)
 
 	// Perform the division
 	result := s.newValue2(s.ssaOp(n.Op(), n.Type()), a.Type, a, b)
 	return result
 }
 
+// isMinIntAndNegOne returns a boolean value that is true when a is the minimum
+// value of the signed integer type t and b is -1.
+func (s *state) isMinIntAndNegOne(t *types.Type, a, b *ssa.Value) *ssa.Value {
+	minInt := int64(-1) << (8*t.Size() - 1)
+	aIsMinInt := s.newValue2(s.ssaOp(ir.OEQ, t), types.Types[types.TBOOL], a, s.constIntOfSize(t, minInt))
+	bIsNegOne := s.newValue2(s.ssaOp(ir.OEQ, t), types.Types[types.TBOOL], b, s.constIntOfSize(t, -1))
+	return s.newValue2(ssa.OpAndB, types.Types[types.TBOOL], aIsMinInt, bIsNegOne)
+}
+
+// constIntOfSize returns the constant c as a value of integer type t,
+// using the constant op that matches the size of t.
+func (s *state) constIntOfSize(t *types.Type, c int64) *ssa.Value {
+	switch t.Size() {
+	case 1:
+		return s.constInt8(t, int8(c))
+	case 2:
+		return s.constInt16(t, int16(c))
+	case 4:
+		return s.constInt32(t, int32(c))
+	case 8:
+		return s.constInt64(t, c)
+	}
+	s.Fatalf("bad integer size %d for %v", t.Size(), t)
+	return nil
+}
+
+// checkedIntOpKey identifies a checked arithmetic op by source operation,
+// operand size and signedness.
+type checkedIntOpKey struct {
+	op     ir.Op
+	size   int64
+	signed bool
+}
+
+// checkedIntOps maps arithmetic operations on 32- and 64-bit integers to the
+// SSA ops that return the truncated result together with an overflow bit.
+var checkedIntOps = map[checkedIntOpKey]ssa.Op{
+	{ir.OADD, 4, true}:  ssa.OpAdd32over,
+	{ir.OADD, 8, true}:  ssa.OpAdd64over,
+	{ir.OADD, 4, false}: ssa.OpAdd32uover,
+	{ir.OADD, 8, false}: ssa.OpAdd64uover,
+	{ir.OSUB, 4, true}:  ssa.OpSub32over,
+	{ir.OSUB, 8, true}:  ssa.OpSub64over,
+	{ir.OSUB, 4, false}: ssa.OpSub32uover,
+	{ir.OSUB, 8, false}: ssa.OpSub64uover,
+	{ir.OMUL, 4, true}:  ssa.OpMul32over,
+	{ir.OMUL, 8, true}:  ssa.OpMul64over,
+	{ir.OMUL, 4, false}: ssa.OpMul32uover,
+	{ir.OMUL, 8, false}: ssa.OpMul64uover,
+}
+
+// checkedIntOp computes n.Op() on a and b with a single overflow test and
+// panics if the result does not fit in n.Type().
+// It reports false if the target architecture has no lowering for the checked
+// ops, in which case the caller emits the generic comparison sequence instead.
+func (s *state) checkedIntOp(n ir.Node, a, b *ssa.Value) (*ssa.Value, bool) {
+	result, fits := s.fittingIntOp(n.Op(), n.Type(), a, b)
+	if result == nil {
+		return nil, false
+	}
+	// s.checkOverflow() panics when condition is FALSE, so pass the "result fits" condition
+	s.checkOverflow(fits, n, a, b)
+	return result, true
+}
+
+// fittingIntOp computes op on a and b of integer type t and reports
+// whether the result fits in t, that is, whether the operation did not
+// overflow. 32- and 64-bit operations use the checked SSA ops, which
+// lower to flag based branches. Narrower operations are done in 32 bits
+// and tested for fitting back in t.
+// It returns nils if the target architecture has no lowering for the
+// checked ops.
+func (s *state) fittingIntOp(op ir.Op, t *types.Type, a, b *ssa.Value) (result, fits *ssa.Value) {
+	if t.Size() < 4 {
+		return s.widenedIntOp(op, t, a, b)
+	}
+	switch Arch.LinkArch.Family {
+	case sys.AMD64, sys.ARM64, sys.RISCV64:
+	default:
+		return nil, nil
+	}
+	checked, ok := checkedIntOps[checkedIntOpKey{op, t.Size(), t.IsSigned()}]
+	if !ok {
+		return nil, nil
+	}
+	pair := s.newValue2(checked, types.NewTuple(t, types.Types[types.TBOOL]), a, b)
+	result = s.newValue1(ssa.OpSelect0, t, pair)
+	overflow := s.newValue1(ssa.OpSelect1, types.Types[types.TBOOL], pair)
+	return result, s.newValue1(ssa.OpNot, types.Types[types.TBOOL], overflow)
+}
+
+// widenedIntOp computes op on 8- or 16-bit operands a and b of type t in
+// 32 bits, which cannot overflow, and reports whether the result survives
+// truncation back to t.
+func (s *state) widenedIntOp(op ir.Op, t *types.Type, a, b *ssa.Value) (result, fits *ssa.Value) {
+	var ext, trunc ssa.Op
+	var wt *types.Type
+	switch {
+	case t.Size() == 1 && t.IsSigned():
+		ext, trunc, wt = ssa.OpSignExt8to32, ssa.OpTrunc32to8, types.Types[types.TINT32]
+	case t.Size() == 1:
+		ext, trunc, wt = ssa.OpZeroExt8to32, ssa.OpTrunc32to8, types.Types[types.TUINT32]
+	case t.Size() == 2 && t.IsSigned():
+		ext, trunc, wt = ssa.OpSignExt16to32, ssa.OpTrunc32to16, types.Types[types.TINT32]
+	case t.Size() == 2:
+		ext, trunc, wt = ssa.OpZeroExt16to32, ssa.OpTrunc32to16, types.Types[types.TUINT32]
+	default:
+		s.Fatalf("bad integer size %d for %v", t.Size(), t)
+	}
+	wide := s.newValue2(s.ssaOp(op, wt), wt, s.newValue1(ext, wt, a), s.newValue1(ext, wt, b))
+	result = s.newValue1(trunc, t, wide)
+	fits = s.newValue2(ssa.OpEq32, types.Types[types.TBOOL], s.newValue1(ext, wt, result), wide)
+	return result, fits
+}
+
 // rtcall issues a call to the given runtime function fn with the listed args.
 // Returns a slice of results of the given result types.
 // The call is added to the end of the current block.
@@ -7528,6 +8050,17 @@ type State struct {
 
 	// wasm: The number of values on the WebAssembly stack. This is only used as a safeguard.
 	OnWasmStackSkipped int
+
+	// arithChecks maps the calls of inserted go-panikint checks
+	// to the checks, so their call sites can be recorded.
+	arithChecks map[arithCall]*ssa.ArithCheck
+}
+
+// An arithCall identifies the call to the panic or report
+// function of an inserted go-panikint check.
+type arithCall struct {
+	pos src.XPos
+	fn  *obj.LSym
 }
 
 func (s *State) FuncInfo() *obj.FuncInfo {
@@ -7799,6 +8332,21 @@ func genssa(f *ssa.Func, pp *objw.Progs) {
 	// Remember where each block starts.
 	s.bstart = make([]*obj.Prog, f.NumBlocks())
 	s.pp = pp
+	for i := range f.ArithChecks {
+		c := &f.ArithChecks[i]
+		if c.Status != ssa.ArithCheckInserted {
+			continue
+		}
+		if s.arithChecks == nil {
+			s.arithChecks = make(map[arithCall]*ssa.ArithCheck)
+		}
+		s.arithChecks[arithCall{c.Pos.WithNotStmt(), c.Fn}] = c
+		if c.Failure != "" {
+			failingArithChecksMu.Lock()
+			failingArithChecks = append(failingArithChecks, failingArithCheck{c.Pos, c.Failure})
+			failingArithChecksMu.Unlock()
+		}
+	}
 	var progToValue map[*obj.Prog]*ssa.Value
 	var progToBlock map[*obj.Prog]*ssa.Block
 	var valueToProgAfter []*obj.Prog // The first Prog following computation of a value v; v is visible at this point.
@@ -8666,6 +9214,16 @@ func (s *State) Call(v *ssa.Value) *obj.Prog {
 		p.To.Type = obj.TYPE_MEM
 		p.To.Name = obj.NAME_EXTERN
 		p.To.Sym = sym.Fn
+		if c := s.arithChecks[arithCall{v.Pos.WithNotStmt(), sym.Fn}]; c != nil {
+			fi := s.FuncInfo()
+			fi.ArithSites = append(fi.ArithSites, obj.ArithSite{
+				Call:  p,
+				Class: c.Class,
+				Op:    c.Op,
+				Src:   ssa.ArithTypeName(c.Src),
+				Dst:   ssa.ArithTypeName(c.Dst),
+			})
+		}
 	} else {
 		// TODO(mdempsky): Can these differences be eliminated?
 		switch Arch.LinkArch.Family {
@@ -83,8 +83,8 @@ func (s *scanner) setLit(kind LitKind, ok bool) {
 // which can be used to distinguish these handler calls from errors.
 //
 // If the scanner mode includes the directives (but not the comments)
-// flag, only comments containing a //line, /*line, or //go: directive
-// are reported, in the same way as regular comments.
+// flag, only comments containing a //line, /*line, //go:, or //panikint:
+// directive are reported, in the same way as regular comments.
 func (s *scanner) next() {
 	nlsemi := s.nlsemi
 	s.nlsemi = false
@@ -747,16 +747,19 @@ func (s *scanner) lineComment() {
 	}
 
 	// are we saving directives? or is this definitely not a directive?
-	if s.mode&directives == 0 || (s.ch != 'g' && s.ch != 'l') {
+	if s.mode&directives == 0 || (s.ch != 'g' && s.ch != 'l' && s.ch != 'p') {
 		s.stop()
 		s.skipLine()
 		return
 	}
 
-	// recognize go: or line directives
+	// recognize go:, panikint: or line directives
 	prefix := "go:"
-	if s.ch == 'l' {
+	switch s.ch {
+	case 'l':
 		prefix = "line "
+	case 'p':
+		prefix = "panikint:"
 	}
 	for _, m := range prefix {
 		if s.ch != m {
@@ -704,6 +704,12 @@ func TestDirectives(t *testing.T) {
 		"//go :foo",
 		"//go:foo",
 		"//go:foo%bar",
+
+		"panikint",
+		"// panikint:",
+		"//panikint:",
+		"//panikint :ignore",
+		"//panikint:ignore overflow",
 	} {
 		got := ""
 		var s scanner
@@ -718,7 +724,7 @@ func TestDirectives(t *testing.T) {
 		}, directives)
 
 		s.next()
-		if strings.HasPrefix(src, "//line ") || strings.HasPrefix(src, "//go:") {
+		if strings.HasPrefix(src, "//line ") || strings.HasPrefix(src, "//go:") || strings.HasPrefix(src, "//panikint:") {
 			// handler should have been called
 			if got != src {
 				t.Errorf("got %s; want %s", got, src)
@@ -38,7 +38,7 @@ type ErrorHandler func(err error)
 // except that nil is used to mean “no pragma seen.”
 type Pragma any
 
-// A PragmaHandler is used to process //go: directives while scanning.
+// A PragmaHandler is used to process //go: and //panikint: directives while scanning.
 // It is passed the current pragma value, which starts out being nil,
 // and it returns an updated pragma value.
 // The text is the directive, with the "//" prefix stripped.
@@ -1239,10 +1239,12 @@ func Conv(n ir.Node, t *types.Type) ir.Node {
 	if types.IdenticalStrict(n.Type(), t) {
 		return n
 	}
-	n = ir.NewConvExpr(base.Pos, ir.OCONV, nil, n)
-	n.SetType(t)
-	n = Expr(n)
-	return n
+	conv := ir.NewConvExpr(base.Pos, ir.OCONV, nil, n)
+	conv.SetType(t)
+	// The conversion is inserted by the compiler, which knows
+	// the value fits, so it is not checked for truncation.
+	conv.SetNoArithCheck(true)
+	return Expr(conv)
 }
 
 // ConvNop converts node n to type t using the OCONVNOP op
@@ -0,0 +1,78 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package types
+
+import (
+	"strconv"
+	"sync"
+)
+
+// An ArithType holds the //panikint: directives of a defined integer
+// type, which change how the compiler instruments its arithmetic.
+type ArithType struct {
+	// Name is the name of the type in runtime errors, as in "pkg.T".
+	Name string
+
+	// Wrapping is set by //panikint:wrapping: the arithmetic whose
+	// result is of the type wraps around and is not checked.
+	Wrapping bool
+
+	// Range is set by //panikint:range lo..hi: the conversions to the
+	// type and the arithmetic whose result is of the type are checked
+	// to give values from Lo to Hi. The bounds are sign or zero
+	// extended to 64 bits according to the underlying type.
+	Range  bool
+	Lo, Hi uint64
+}
+
+// InRange reports whether the value v of type t, sign or zero extended
+// to 64 bits, lies within the range declared for t.
+func (a *ArithType) InRange(t *Type, v uint64) bool {
+	if !a.Range {
+		return true
+	}
+	if t.IsSigned() {
+		return int64(a.Lo) <= int64(v) && int64(v) <= int64(a.Hi)
+	}
+	return a.Lo <= v && v <= a.Hi
+}
+
+// RangeString returns the range declared for t, as in "0..100".
+func (a *ArithType) RangeString(t *Type) string {
+	if t.IsSigned() {
+		return strconv.FormatInt(int64(a.Lo), 10) + ".." + strconv.FormatInt(int64(a.Hi), 10)
+	}
+	return strconv.FormatUint(a.Lo, 10) + ".." + strconv.FormatUint(a.Hi, 10)
+}
+
+// arithTypes maps the types with //panikint: directives to them.
+// Types are read concurrently by the backend.
+var arithTypes struct {
+	sync.RWMutex
+	m map[*Type]*ArithType
+}
+
+// Arith returns the //panikint: directives of type t, or nil if it has
+// none. The shape type of an annotated type stands for that type alone,
+// and has the same directives.
+func (t *Type) Arith() *ArithType {
+	if t.flags&typeArith == 0 {
+		return nil
+	}
+	arithTypes.RLock()
+	defer arithTypes.RUnlock()
+	return arithTypes.m[t]
+}
+
+// SetArith sets the //panikint: directives of type t.
+func (t *Type) SetArith(a *ArithType) {
+	arithTypes.Lock()
+	defer arithTypes.Unlock()
+	if arithTypes.m == nil {
+		arithTypes.m = make(map[*Type]*ArithType)
+	}
+	arithTypes.m[t] = a
+	t.flags.set(typeArith, true)
+}
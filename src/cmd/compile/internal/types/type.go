@@ -233,6 +233,7 @@ const (
 	// typeIsFullyInstantiated reports whether a type is fully instantiated generic type; i.e.
 	// an instantiated generic type where all type arguments are non-generic or fully instantiated generic types.
 	typeIsFullyInstantiated
+	typeArith // defined type with //panikint: directives, see Arith
 )
 
 func (t *Type) NotInHeap() bool           { return t.flags&typeNotInHeap != 0 }
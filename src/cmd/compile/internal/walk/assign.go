@@ -49,7 +49,10 @@ func walkAssign(init *ir.Nodes, n ir.Node) ir.Node {
 
 	if n.Op() == ir.OASOP {
 		// Rewrite x op= y into x = x op y.
-		n = ir.NewAssignStmt(base.Pos, left, typecheck.Expr(ir.NewBinaryExpr(base.Pos, n.(*ir.AssignOpStmt).AsOp, left, right)))
+		asop := n.(*ir.AssignOpStmt)
+		bin := ir.NewBinaryExpr(base.Pos, asop.AsOp, left, right)
+		bin.SetNoArithCheck(asop.NoArithCheck)
+		n = ir.NewAssignStmt(base.Pos, left, typecheck.Expr(bin))
 	} else {
 		n.(*ir.AssignStmt).X = left
 	}
@@ -510,7 +513,9 @@ func appendSlice(n *ir.CallExpr, init *ir.Nodes) ir.Node {
 
 	// newLen := oldLen + num
 	newLen := typecheck.TempAt(base.Pos, ir.CurFunc, types.Types[types.TINT])
-	nodes.Append(ir.NewAssignStmt(base.Pos, newLen, ir.NewBinaryExpr(base.Pos, ir.OADD, oldLen, num)))
+	add := ir.NewBinaryExpr(base.Pos, ir.OADD, oldLen, num)
+	add.SetNoArithCheck(true) // growslice reports the overflow
+	nodes.Append(ir.NewAssignStmt(base.Pos, newLen, add))
 
 	// if uint(newLen) <= uint(oldCap)
 	nif := ir.NewIfStmt(base.Pos, nil, nil, nil)
@@ -537,6 +542,7 @@ func appendSlice(n *ir.CallExpr, init *ir.Nodes) ir.Node {
 	// Note: this doesn't work optimally currently because
 	// the compiler optimizer undoes this arithmetic.
 	idx := ir.NewBinaryExpr(base.Pos, ir.OSUB, newLen, ir.NewUnaryExpr(base.Pos, ir.OLEN, l2))
+	idx.SetNoArithCheck(true) // undoes the addition above
 
 	var ncopy ir.Node
 	if elemtype.HasPointers() {
@@ -695,7 +701,9 @@ func extendSlice(n *ir.CallExpr, init *ir.Nodes) ir.Node {
 
 	// n := s.len + l2
 	nn := typecheck.TempAt(base.Pos, ir.CurFunc, types.Types[types.TINT])
-	nifnz.Body = append(nifnz.Body, ir.NewAssignStmt(base.Pos, nn, ir.NewBinaryExpr(base.Pos, ir.OADD, ir.NewUnaryExpr(base.Pos, ir.OLEN, s), l2)))
+	add := ir.NewBinaryExpr(base.Pos, ir.OADD, ir.NewUnaryExpr(base.Pos, ir.OLEN, s), l2)
+	add.SetNoArithCheck(true) // growslice reports the overflow
+	nifnz.Body = append(nifnz.Body, ir.NewAssignStmt(base.Pos, nn, add))
 
 	// if uint(n) <= uint(s.cap)
 	nuint := typecheck.Conv(nn, types.Types[types.TUINT])
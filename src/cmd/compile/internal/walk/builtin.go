@@ -88,7 +88,9 @@ func walkAppend(n *ir.CallExpr, init *ir.Nodes, dst ir.Node) ir.Node {
 
 	// newLen := s.len + num
 	newLen := typecheck.TempAt(base.Pos, ir.CurFunc, types.Types[types.TINT])
-	l = append(l, ir.NewAssignStmt(base.Pos, newLen, ir.NewBinaryExpr(base.Pos, ir.OADD, ir.NewUnaryExpr(base.Pos, ir.OLEN, s), num)))
+	add := ir.NewBinaryExpr(base.Pos, ir.OADD, ir.NewUnaryExpr(base.Pos, ir.OLEN, s), num)
+	add.SetNoArithCheck(true) // growslice reports the overflow
+	l = append(l, ir.NewAssignStmt(base.Pos, newLen, add))
 
 	// if uint(newLen) <= uint(s.cap)
 	nif := ir.NewIfStmt(base.Pos, nil, nil, nil)
@@ -865,7 +867,9 @@ func walkUnsafeSlice(n *ir.BinaryExpr, init *ir.Nodes) ir.Node {
 		//     panicunsafeslicelen()
 		// }
 		nif = ir.NewIfStmt(base.Pos, nil, nil, nil)
-		memCond := ir.NewBinaryExpr(base.Pos, ir.OGT, mem, ir.NewUnaryExpr(base.Pos, ir.ONEG, typecheck.Conv(unsafePtr, types.Types[types.TUINTPTR])))
+		negPtr := ir.NewUnaryExpr(base.Pos, ir.ONEG, typecheck.Conv(unsafePtr, types.Types[types.TUINTPTR]))
+		negPtr.SetNoArithCheck(true) // wraps on purpose to the room left above ptr
+		memCond := ir.NewBinaryExpr(base.Pos, ir.OGT, mem, negPtr)
 		nif.Cond = ir.NewLogicalExpr(base.Pos, ir.OOROR, overflow, memCond)
 		nifPtr := ir.NewIfStmt(base.Pos, nil, nil, nil)
 		nifPtr.Cond = ir.NewBinaryExpr(base.Pos, ir.OEQ, unsafePtr, typecheck.NodNil())
@@ -927,7 +931,9 @@ func walkUnsafeString(n *ir.BinaryExpr, init *ir.Nodes) ir.Node {
 		//    panicunsafeslicelen()
 		// }
 		nifLen := ir.NewIfStmt(base.Pos, nil, nil, nil)
-		nifLen.Cond = ir.NewBinaryExpr(base.Pos, ir.OGT, typecheck.Conv(len, types.Types[types.TUINTPTR]), ir.NewUnaryExpr(base.Pos, ir.ONEG, typecheck.Conv(unsafePtr, types.Types[types.TUINTPTR])))
+		negPtr := ir.NewUnaryExpr(base.Pos, ir.ONEG, typecheck.Conv(unsafePtr, types.Types[types.TUINTPTR]))
+		negPtr.SetNoArithCheck(true) // wraps on purpose to the room left above ptr
+		nifLen.Cond = ir.NewBinaryExpr(base.Pos, ir.OGT, typecheck.Conv(len, types.Types[types.TUINTPTR]), negPtr)
 		nifPtr := ir.NewIfStmt(base.Pos, nil, nil, nil)
 		nifPtr.Cond = ir.NewBinaryExpr(base.Pos, ir.OEQ, unsafePtr, typecheck.NodNil())
 		nifPtr.Body.Append(mkcall("panicunsafestringnilptr", nil, &nifPtr.Body))
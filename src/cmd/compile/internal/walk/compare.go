@@ -291,13 +291,15 @@ func walkCompare(n *ir.BinaryExpr, init *ir.Nodes) ir.Node {
 					lb := ir.Node(ir.NewIndexExpr(base.Pos, cmpl, ir.NewInt(base.Pos, i+offset)))
 					lb = typecheck.Conv(lb, elemType)
 					lb = typecheck.Conv(lb, convType)
-					lb = ir.NewBinaryExpr(base.Pos, ir.OLSH, lb, ir.NewInt(base.Pos, 8*t.Elem().Size()*offset))
-					cmplw = ir.NewBinaryExpr(base.Pos, ir.OOR, cmplw, lb)
+					lsh := ir.NewBinaryExpr(base.Pos, ir.OLSH, lb, ir.NewInt(base.Pos, 8*t.Elem().Size()*offset))
+					lsh.SetNoArithCheck(true) // widened above, so no bits are lost
+					cmplw = ir.NewBinaryExpr(base.Pos, ir.OOR, cmplw, lsh)
 					rb := ir.Node(ir.NewIndexExpr(base.Pos, cmpr, ir.NewInt(base.Pos, i+offset)))
 					rb = typecheck.Conv(rb, elemType)
 					rb = typecheck.Conv(rb, convType)
-					rb = ir.NewBinaryExpr(base.Pos, ir.OLSH, rb, ir.NewInt(base.Pos, 8*t.Elem().Size()*offset))
-					cmprw = ir.NewBinaryExpr(base.Pos, ir.OOR, cmprw, rb)
+					rsh := ir.NewBinaryExpr(base.Pos, ir.OLSH, rb, ir.NewInt(base.Pos, 8*t.Elem().Size()*offset))
+					rsh.SetNoArithCheck(true)
+					cmprw = ir.NewBinaryExpr(base.Pos, ir.OOR, cmprw, rsh)
 				}
 				comp(cmplw, cmprw)
 				i += step
@@ -432,8 +434,9 @@ func walkCompareString(n *ir.BinaryExpr, init *ir.Nodes) ir.Node {
 				// ssa will combine this into a single large load.
 				for offset := 1; offset < step; offset++ {
 					b := typecheck.Conv(ir.NewIndexExpr(base.Pos, ncs, ir.NewInt(base.Pos, int64(i+offset))), convType)
-					b = ir.NewBinaryExpr(base.Pos, ir.OLSH, b, ir.NewInt(base.Pos, int64(8*offset)))
-					ncsubstr = ir.NewBinaryExpr(base.Pos, ir.OOR, ncsubstr, b)
+					sh := ir.NewBinaryExpr(base.Pos, ir.OLSH, b, ir.NewInt(base.Pos, int64(8*offset)))
+					sh.SetNoArithCheck(true) // widened above, so no bits are lost
+					ncsubstr = ir.NewBinaryExpr(base.Pos, ir.OOR, ncsubstr, sh)
 					csubstr |= int64(s[i+offset]) << uint8(8*offset)
 				}
 				csubstrPart := ir.NewInt(base.Pos, csubstr)
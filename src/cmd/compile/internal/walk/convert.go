@@ -166,6 +166,7 @@ func dataWord(conv *ir.ConvExpr, init *ir.Nodes) ir.Node {
 		n = soleComponent(init, n)
 		// byteindex widens n so that the multiplication doesn't overflow.
 		index := ir.NewBinaryExpr(base.Pos, ir.OLSH, byteindex(n), ir.NewInt(base.Pos, 3))
+		index.SetNoArithCheck(true)
 		if ssagen.Arch.LinkArch.ByteOrder == binary.BigEndian {
 			index = ir.NewBinaryExpr(base.Pos, ir.OADD, index, ir.NewInt(base.Pos, 7))
 		}
@@ -228,7 +229,9 @@ func dataWord(conv *ir.ConvExpr, init *ir.Nodes) ir.Node {
 			arg = ir.NewConvExpr(pos, ir.OCONVNOP, argType, n)
 		case fromType.IsInteger() && argType.IsInteger():
 			// can directly convert (e.g. int32 to uint32)
-			arg = ir.NewConvExpr(pos, ir.OCONV, argType, n)
+			conv := ir.NewConvExpr(pos, ir.OCONV, argType, n)
+			conv.SetNoArithCheck(true) // reinterprets the bits
+			arg = conv
 		default:
 			// unsafe cast through memory
 			arg = copyExpr(n, fromType, init)
@@ -468,9 +471,11 @@ func byteindex(n ir.Node) ir.Node {
 	// the wrong result for negative values.
 	// Reinterpreting the value as an unsigned byte solves both cases.
 	if !types.Identical(n.Type(), types.Types[types.TUINT8]) {
-		n = ir.NewConvExpr(base.Pos, ir.OCONV, nil, n)
-		n.SetType(types.Types[types.TUINT8])
-		n.SetTypecheck(1)
+		conv := ir.NewConvExpr(base.Pos, ir.OCONV, nil, n)
+		conv.SetType(types.Types[types.TUINT8])
+		conv.SetTypecheck(1)
+		conv.SetNoArithCheck(true) // reinterprets the bits
+		n = conv
 	}
 	n = ir.NewConvExpr(base.Pos, ir.OCONV, nil, n)
 	n.SetType(types.Types[types.TINT])
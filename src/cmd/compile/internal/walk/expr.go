@@ -137,7 +137,9 @@ func walkExpr1(n ir.Node, init *ir.Nodes) ir.Node {
 			// for the side effects of validating unsafe.Pointer rules.
 			x := typecheck.ConvNop(n.X, types.Types[types.TUINTPTR])
 			y := typecheck.Conv(n.Y, types.Types[types.TUINTPTR])
-			conv := typecheck.ConvNop(ir.NewBinaryExpr(n.Pos(), ir.OADD, x, y), types.Types[types.TUNSAFEPTR])
+			add := ir.NewBinaryExpr(n.Pos(), ir.OADD, x, y)
+			add.SetNoArithCheck(true) // a negative offset wraps on purpose
+			conv := typecheck.ConvNop(add, types.Types[types.TUNSAFEPTR])
 			walkExpr(conv, init)
 		}
 		return n
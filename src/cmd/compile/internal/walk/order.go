@@ -327,7 +327,9 @@ func (o *orderState) mapKeyTemp(outerPos src.XPos, t *types.Type, n ir.Node) ir.
 			n.SetType(kt)
 			return n
 		}
-		return typecheck.Expr(ir.NewConvExpr(pos, ir.OCONV, kt, n))
+		conv := ir.NewConvExpr(pos, ir.OCONV, kt, n)
+		conv.SetNoArithCheck(true) // reinterprets the bits
+		return typecheck.Expr(conv)
 	default:
 		// Unsafe cast through memory.
 		// We'll need to do a load with type kt. Create a temporary of type kt to
@@ -720,7 +722,9 @@ func (o *orderState) stmt(n ir.Node) {
 				l2.Assigned = false
 			}
 			l2 = o.copyExpr(l2)
-			r := o.expr(typecheck.Expr(ir.NewBinaryExpr(n.Pos(), n.AsOp, l2, n.Y)), nil)
+			bin := ir.NewBinaryExpr(n.Pos(), n.AsOp, l2, n.Y)
+			bin.SetNoArithCheck(n.NoArithCheck)
+			r := o.expr(typecheck.Expr(bin), nil)
 			as := typecheck.Stmt(ir.NewAssignStmt(n.Pos(), l1, r))
 			o.mapAssign(as)
 			o.popTemp(t)
@@ -542,7 +542,9 @@ func tryLookupTable(sw *ir.SwitchStmt, cond ir.Node) {
 	var idx ir.Node
 	if minVal != 0 {
 		minLit := ir.NewBasicLit(pos, intType, constant.MakeInt64(minVal))
-		idx = typecheck.Expr(ir.NewBinaryExpr(pos, ir.OSUB, wideCond, minLit))
+		sub := ir.NewBinaryExpr(pos, ir.OSUB, wideCond, minLit)
+		sub.SetNoArithCheck(true) // wraps on purpose; the bounds check below rejects it
+		idx = typecheck.Expr(sub)
 	} else {
 		idx = wideCond
 	}
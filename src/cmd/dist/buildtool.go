@@ -52,6 +52,7 @@ var bootstrapDirs = []string{
 	"cmd/internal/par",
 	"cmd/internal/pgo",
 	"cmd/internal/pkgpath",
+	"cmd/internal/pkgpattern",
 	"cmd/internal/quoted",
 	"cmd/internal/src",
 	"cmd/internal/sys",
@@ -135,6 +135,16 @@
 //		Supported on linux/amd64 or linux/arm64 and only with GCC 7 and higher
 //		or Clang/LLVM 9 and higher.
 //		And supported on linux/loong64 only with Clang/LLVM 16 and higher.
+//	-panikint check1,check2,...
+//		enable the integer checks of go-panikint in the listed classes
+//		and disable the others, in the main packages and all their
+//		dependencies. The classes are overflow (addition, subtraction,
+//		multiplication and division), negation, truncation, shift,
+//		floatconv and int32, which checks on 64-bit targets that int and
+//		uint values fit in 32 bits; all enables every class but int32.
+//		As int32 extends the checks of the other classes, it enables the
+//		default classes overflow and negation if it is listed alone.
+//		The setting is recorded in the build information of the binary.
 //	-cover
 //		enable code coverage instrumentation.
 //	-covermode set,count,atomic
@@ -184,7 +194,8 @@
 //		in order to keep output separate from default builds.
 //		If using the -race flag, the install suffix is automatically set to race
 //		or, if set explicitly, has _race appended to it. Likewise for the -msan
-//		and -asan flags. Using a -buildmode option that requires non-default compile
+//		and -asan flags, and -panikint appends panikint followed by the enabled
+//		check classes. Using a -buildmode option that requires non-default compile
 //		flags has a similar effect.
 //	-json
 //		Emit build output in JSON suitable for automated processing.
@@ -2444,6 +2444,9 @@ func (p *Package) setBuildInfo(ctx context.Context, f *modfetch.Fetcher, autoVCS
 	if cfg.BuildMSan {
 		appendSetting("-msan", "true")
 	}
+	if len(cfg.BuildPanikint) > 0 {
+		appendSetting("-panikint", strings.Join(cfg.BuildPanikint, ","))
+	}
 	// N.B. -pgo added later by setPGOProfilePath.
 	if cfg.BuildRace {
 		appendSetting("-race", "true")
@@ -13,6 +13,7 @@ import (
 	"os"
 	"path/filepath"
 	"runtime"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -91,6 +92,16 @@ and test commands:
 		Supported on linux/amd64 or linux/arm64 and only with GCC 7 and higher
 		or Clang/LLVM 9 and higher.
 		And supported on linux/loong64 only with Clang/LLVM 16 and higher.
+	-panikint check1,check2,...
+		enable the integer checks of go-panikint in the listed classes
+		and disable the others, in the main packages and all their
+		dependencies. The classes are overflow (addition, subtraction,
+		multiplication and division), negation, truncation, shift,
+		floatconv and int32, which checks on 64-bit targets that int and
+		uint values fit in 32 bits; all enables every class but int32.
+		As int32 extends the checks of the other classes, it enables the
+		default classes overflow and negation if it is listed alone.
+		The setting is recorded in the build information of the binary.
 	-cover
 		enable code coverage instrumentation.
 	-covermode set,count,atomic
@@ -140,7 +151,8 @@ and test commands:
 		in order to keep output separate from default builds.
 		If using the -race flag, the install suffix is automatically set to race
 		or, if set explicitly, has _race appended to it. Likewise for the -msan
-		and -asan flags. Using a -buildmode option that requires non-default compile
+		and -asan flags, and -panikint appends panikint followed by the enabled
+		check classes. Using a -buildmode option that requires non-default compile
 		flags has a similar effect.
 	-json
 		Emit build output in JSON suitable for automated processing.
@@ -347,6 +359,7 @@ func AddBuildFlags(cmd *base.Command, mask BuildFlagMask) {
 	cmd.Flag.Var(&load.BuildLdflags, "ldflags", "")
 	cmd.Flag.BoolVar(&cfg.BuildLinkshared, "linkshared", false, "")
 	cmd.Flag.BoolVar(&cfg.BuildMSan, "msan", false, "")
+	cmd.Flag.Var((*panikintFlag)(&cfg.BuildPanikint), "panikint", "")
 	cmd.Flag.StringVar(&cfg.BuildPGO, "pgo", "auto", "")
 	cmd.Flag.StringVar(&cfg.BuildPkgdir, "pkgdir", "", "")
 	cmd.Flag.BoolVar(&cfg.BuildRace, "race", false, "")
@@ -419,6 +432,57 @@ func (f *buildvcsFlag) Set(s string) error {
 
 func (f *buildvcsFlag) String() string { return string(*f) }
 
+// panikintChecks lists the check classes accepted by -panikint,
+// in the order they are reported. All of them but the 32-bit
+// portability checks of int32 are enabled by "all".
+var panikintChecks = []string{"overflow", "negation", "truncation", "shift", "floatconv", "int32"}
+
+// panikintDefaultChecks lists the check classes the compiler enables
+// when -panikint is not given. It must match the defaults set in
+// cmd/compile/internal/base.
+var panikintDefaultChecks = []string{"overflow", "negation"}
+
+// panikintFlag is the implementation of the -panikint flag.
+type panikintFlag []string
+
+func (f *panikintFlag) Set(s string) error {
+	if s == "" {
+		*f = nil
+		return nil
+	}
+	enabled := make(map[string]bool)
+	for _, check := range strings.Split(s, ",") {
+		switch {
+		case check == "all":
+			for _, c := range panikintChecks {
+				if c != "int32" {
+					enabled[c] = true
+				}
+			}
+		case slices.Contains(panikintChecks, check):
+			enabled[check] = true
+		default:
+			return fmt.Errorf("unknown check class %q (want all or a list of %s)", check, strings.Join(panikintChecks, ", "))
+		}
+	}
+	// int32 only extends the checks of the other classes to 32 bits,
+	// so on its own it goes with the default ones.
+	if len(enabled) == 1 && enabled["int32"] {
+		for _, c := range panikintDefaultChecks {
+			enabled[c] = true
+		}
+	}
+	*f = nil
+	for _, c := range panikintChecks {
+		if enabled[c] {
+			*f = append(*f, c)
+		}
+	}
+	return nil
+}
+
+func (f *panikintFlag) String() string { return strings.Join(*f, ",") }
+
 // fileExtSplit expects a filename and returns the name
 // and ext (without the dot). If the file has no
 // extension, ext will be empty.
@@ -22,6 +22,7 @@ import (
 	"runtime"
 	"slices"
 	"strconv"
+	"strings"
 	"sync"
 )
 
@@ -59,6 +60,7 @@ func BuildInit(ld *modload.Loader) {
 
 	modload.Init(ld)
 	instrumentInit()
+	panikintInit()
 	buildModeInit()
 	initCompilerConcurrencyPool()
 	cfgChangedEnv = makeCfgChangedEnv()
@@ -212,6 +214,28 @@ func instrumentInit() {
 	cfg.BuildContext.ToolTags = append(cfg.BuildContext.ToolTags, mode)
 }
 
+// panikintInit forwards the -panikint flag to the compiler and sets the
+// panikint build tags. Each check class is turned on or off explicitly,
+// so that the compiler defaults do not matter, and the install suffix
+// keeps the resulting archives apart from plain ones.
+func panikintInit() {
+	if len(cfg.BuildPanikint) == 0 {
+		return
+	}
+	cfg.BuildContext.ToolTags = append(cfg.BuildContext.ToolTags, "panikint")
+	for _, check := range cfg.BuildPanikint {
+		cfg.BuildContext.ToolTags = append(cfg.BuildContext.ToolTags, "panikint_"+check)
+	}
+	for _, check := range panikintChecks {
+		forcedGcflags = append(forcedGcflags, fmt.Sprintf("-%sdetect=%v", check, slices.Contains(cfg.BuildPanikint, check)))
+	}
+
+	if cfg.BuildContext.InstallSuffix != "" {
+		cfg.BuildContext.InstallSuffix += "_"
+	}
+	cfg.BuildContext.InstallSuffix += "panikint_" + strings.Join(cfg.BuildPanikint, "_")
+}
+
 func buildModeInit() {
 	gccgo := cfg.BuildToolchainName == "gccgo"
 	var codegenArg string
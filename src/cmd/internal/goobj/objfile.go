@@ -454,6 +454,7 @@ const (
 	AuxWasmImport
 	AuxWasmType
 	AuxSehUnwindInfo
+	AuxArithSites
 )
 
 func (a *Aux) Type() uint8 { return a[0] }
@@ -0,0 +1,54 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package obj
+
+import (
+	"cmd/internal/objabi"
+	"encoding/binary"
+)
+
+// populateArithSites encodes the go-panikint check sites of s into an
+// auxiliary symbol, which the linker collects into the check site table
+// (see cmd/link/internal/ld.Link.panikint). Each site is encoded as
+//
+//	uvarint pc offset of the call from the start of s
+//	string  file
+//	uvarint line
+//	uvarint column
+//	string  class
+//	string  operation
+//	string  operand type
+//	string  result type
+//
+// where strings are prefixed by their uvarint-encoded length.
+// Positions are those of the innermost inlined call, as adjusted
+// by //line directives.
+func (ctxt *Link) populateArithSites(s *LSym) {
+	fn := s.Func()
+	if len(fn.ArithSites) == 0 {
+		return
+	}
+	var data []byte
+	str := func(s string) {
+		data = binary.AppendUvarint(data, uint64(len(s)))
+		data = append(data, s...)
+	}
+	for _, site := range fn.ArithSites {
+		pos := ctxt.InnermostPos(site.Call.Pos)
+		data = binary.AppendUvarint(data, uint64(site.Call.Pc))
+		str(pos.AbsFilename())
+		data = binary.AppendUvarint(data, uint64(pos.RelLine()))
+		data = binary.AppendUvarint(data, uint64(pos.RelCol()))
+		str(site.Class)
+		str(site.Op)
+		str(site.Src)
+		str(site.Dst)
+	}
+	fn.arithSitesSym = &LSym{
+		Type: objabi.SRODATA, // not laid out by the linker
+		P:    data,
+		Size: int64(len(data)),
+	}
+}
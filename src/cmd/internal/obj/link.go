@@ -506,6 +506,18 @@ type FuncInfo struct {
 	WasmExport *WasmExport
 
 	sehUnwindInfoSym *LSym
+
+	ArithSites    []ArithSite // go-panikint check sites, for the linker's check site table
+	arithSitesSym *LSym
+}
+
+// An ArithSite is a call to the panic or report function of an
+// integer check inserted by go-panikint.
+type ArithSite struct {
+	Call     *Prog  // the call instruction
+	Class    string // check class, as named by the -panikint flag of the go command
+	Op       string // operation, such as "addition" or "conversion"
+	Src, Dst string // operand and result types, named as in runtime.ArithmeticError
 }
 
 // JumpTable represents a table used for implementing multi-way
@@ -630,6 +630,9 @@ func (w *writer) Aux(s *LSym) {
 		if fn.sehUnwindInfoSym != nil && fn.sehUnwindInfoSym.Size != 0 {
 			w.aux1(goobj.AuxSehUnwindInfo, fn.sehUnwindInfoSym)
 		}
+		if fn.arithSitesSym != nil && fn.arithSitesSym.Size != 0 {
+			w.aux1(goobj.AuxArithSites, fn.arithSitesSym)
+		}
 		for _, pcSym := range fn.Pcln.Pcdata {
 			w.aux1(goobj.AuxPcdata, pcSym)
 		}
@@ -745,6 +748,9 @@ func nAuxSym(s *LSym) int {
 		if fn.sehUnwindInfoSym != nil && fn.sehUnwindInfoSym.Size != 0 {
 			n++
 		}
+		if fn.arithSitesSym != nil && fn.arithSitesSym.Size != 0 {
+			n++
+		}
 		n += len(fn.Pcln.Pcdata)
 		if fn.WasmImport != nil {
 			if fn.WasmImport.AuxSym == nil || fn.WasmImport.AuxSym.Size == 0 {
@@ -815,7 +821,7 @@ func genFuncInfoSyms(ctxt *Link) {
 		fn.FuncInfoSym = isym
 		b.Reset()
 
-		auxsyms := []*LSym{fn.dwarfRangesSym, fn.dwarfLocSym, fn.dwarfDebugLinesSym, fn.dwarfInfoSym}
+		auxsyms := []*LSym{fn.dwarfRangesSym, fn.dwarfLocSym, fn.dwarfDebugLinesSym, fn.dwarfInfoSym, fn.arithSitesSym}
 		if wi := fn.WasmImport; wi != nil {
 			auxsyms = append(auxsyms, wi.AuxSym)
 		}
@@ -170,6 +170,7 @@ func Flushplist(ctxt *Link, plist *Plist, newprog ProgAlloc) {
 			continue
 		}
 		linkpcln(ctxt, s)
+		ctxt.populateArithSites(s)
 		ctxt.populateDWARF(plist.Curfn, s)
 		if ctxt.Headtype == objabi.Hwindows && ctxt.Arch.SEH != nil {
 			s.Func().sehUnwindInfoSym = ctxt.Arch.SEH(ctxt, s)
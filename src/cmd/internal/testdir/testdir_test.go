@@ -75,8 +75,6 @@ var (
 // Each .go file test case in GOROOT/test is registered as a subtest with
 // a full name like "Test/fixedbugs/bug000.go" ('/'-separated relative path).
 func Test(t *testing.T) {
-	t.Setenv("GOPANIKINT_DISABLE_OVERFLOW", "1")
-
 	if *target != "" {
 		// When -target is set, propagate it to GOOS/GOARCH in our environment
 		// so that all commands run with the target GOOS/GOARCH.
@@ -182,8 +180,24 @@ func goFiles(t *testing.T, dir string) []string {
 
 type runCmd func(...string) ([]byte, error)
 
+// panikintFlags turns off the integer checks of go-panikint,
+// since the tests rely on integer wrap-around.
+var panikintFlags = []string{
+	"-overflowdetect=false",
+	"-negationdetect=false",
+	"-truncationdetect=false",
+	"-shiftdetect=false",
+	"-floatconvdetect=false",
+}
+
+// compileCmd returns the command line running the compiler with args.
+func compileCmd(args ...string) []string {
+	cmd := append([]string{goTool, "tool", "compile"}, panikintFlags...)
+	return append(cmd, args...)
+}
+
 func compileFile(runcmd runCmd, longname string, flags []string) (out []byte, err error) {
-	cmd := []string{goTool, "tool", "compile", "-e", "-p=p", "-importcfg=" + stdlibImportcfgFile()}
+	cmd := compileCmd("-e", "-p=p", "-importcfg="+stdlibImportcfgFile())
 	cmd = append(cmd, flags...)
 	if *linkshared {
 		cmd = append(cmd, "-dynlink", "-installsuffix=dynlink")
@@ -196,7 +210,7 @@ func compileInDir(runcmd runCmd, dir string, flags []string, importcfg string, p
 	if importcfg == "" {
 		importcfg = stdlibImportcfgFile()
 	}
-	cmd := []string{goTool, "tool", "compile", "-e", "-D", "test", "-importcfg=" + importcfg}
+	cmd := compileCmd("-e", "-D", "test", "-importcfg="+importcfg)
 	if pkgname == "main" {
 		cmd = append(cmd, "-p=main")
 	} else {
@@ -445,7 +459,7 @@ func (ctxt *context) match(name string) bool {
 // or else the commands will rebuild any needed packages (like runtime)
 // over and over.
 func (test) goGcflags() string {
-	return "-gcflags=all=" + os.Getenv("GO_GCFLAGS")
+	return "-gcflags=all=" + os.Getenv("GO_GCFLAGS") + " " + strings.Join(panikintFlags, " ")
 }
 
 func (test) goGcflagsIsEmpty() bool {
@@ -706,7 +720,7 @@ func (t test) run() error {
 				continue
 			}
 			// -S=2 forces outermost line numbers when disassembling inlined code.
-			cmdline := []string{"build", "-gcflags", "-S=2"}
+			cmdline := []string{"build", "-gcflags", "-S=2 " + strings.Join(panikintFlags, " ")}
 
 			// Append flags, but don't override -gcflags=-S=2; add to it instead.
 			for i := 0; i < len(flags); i++ {
@@ -757,7 +771,7 @@ func (t test) run() error {
 		// Fail if wantError is true and compilation was successful and vice versa.
 		// Match errors produced by gc against errors in comments.
 		// TODO(gri) remove need for -C (disable printing of columns in error messages)
-		cmdline := []string{goTool, "tool", "compile", "-p=p", "-d=panic", "-C", "-e", "-importcfg=" + stdlibImportcfgFile(), "-o", "a.o"}
+		cmdline := compileCmd("-p=p", "-d=panic", "-C", "-e", "-importcfg="+stdlibImportcfgFile(), "-o", "a.o")
 		// No need to add -dynlink even if linkshared if we're just checking for errors...
 		cmdline = append(cmdline, flags...)
 		cmdline = append(cmdline, long)
@@ -961,7 +975,7 @@ func (t test) run() error {
 			}
 		}
 		var objs []string
-		cmd := []string{goTool, "tool", "compile", "-p=main", "-e", "-D", ".", "-importcfg=" + stdlibImportcfgFile(), "-o", "go.o"}
+		cmd := compileCmd("-p=main", "-e", "-D", ".", "-importcfg="+stdlibImportcfgFile(), "-o", "go.o")
 		if len(asms) > 0 {
 			cmd = append(cmd, "-asmhdr", "go_asm.h", "-symabis", "symabis")
 		}
@@ -1040,7 +1054,7 @@ func (t test) run() error {
 			// Because we run lots of trivial test programs,
 			// the time adds up.
 			pkg := filepath.Join(tempDir, "pkg.a")
-			if _, err := runcmd(goTool, "tool", "compile", "-p=main", "-importcfg="+stdlibImportcfgFile(), "-o", pkg, t.goFileName()); err != nil {
+			if _, err := runcmd(compileCmd("-p=main", "-importcfg="+stdlibImportcfgFile(), "-o", pkg, t.goFileName())...); err != nil {
 				return err
 			}
 			exe := filepath.Join(tempDir, "test.exe")
@@ -1112,7 +1126,7 @@ func (t test) run() error {
 		if err != nil {
 			t.Fatalf("write tempfile: %v", err)
 		}
-		cmdline := []string{goTool, "tool", "compile", "-importcfg=" + stdlibImportcfgFile(), "-p=p", "-d=panic", "-e", "-o", "a.o"}
+		cmdline := compileCmd("-importcfg="+stdlibImportcfgFile(), "-p=p", "-d=panic", "-e", "-o", "a.o")
 		cmdline = append(cmdline, flags...)
 		cmdline = append(cmdline, tfile)
 		out, err = runcmd(cmdline...)
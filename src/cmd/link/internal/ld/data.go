@@ -1932,6 +1932,7 @@ func (state *dodataState) allocateDataSections(ctxt *Link) {
 	writable := []sym.SymKind{
 		sym.SBUILDINFO,
 		sym.SFIPSINFO,
+		sym.SPANIKINT,
 		sym.SELFSECT,
 		sym.SMACHO,
 		sym.SWINDOWS,
@@ -284,6 +284,11 @@ func (d *deadcodePass) flood() {
 				// type descriptor. Don't mark it.
 				continue
 			}
+			if a.Type() == goobj.AuxArithSites {
+				// Copied into the check site table, not laid out
+				// on its own.
+				continue
+			}
 			d.mark(a.Sym(), symIdx)
 		}
 		// Record sym if package init func (here naux != 0 is a cheap way
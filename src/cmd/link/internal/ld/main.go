@@ -426,6 +426,8 @@ func Main(arch *sys.Arch, theArch Arch) {
 	ctxt.textaddress()
 	bench.Start("buildinfo")
 	ctxt.buildinfo()
+	bench.Start("panikint")
+	ctxt.panikint()
 	bench.Start("pclntab")
 	containers := ctxt.findContainerSyms()
 	pclnState := ctxt.pclntab(containers)
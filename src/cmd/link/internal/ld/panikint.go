@@ -0,0 +1,136 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package ld
+
+import (
+	"cmd/link/internal/sym"
+	"encoding/binary"
+)
+
+// panikint writes the go-panikint check site table, which lists the
+// calls to the panic or report functions of the integer checks inserted
+// by the compiler. The code reading it is in package debug/panikint.
+//
+// The compiler records the sites of each function in an auxiliary
+// symbol (see cmd/internal/obj.Link.populateArithSites). The table
+// identifies functions by name and sites by their offset from the
+// start of the function, so that it needs no relocations. It is a
+// 16-byte header, consisting of the magic, a version byte and a
+// padding byte, followed by
+//
+//	uvarint number of strings
+//	string  ...
+//	uvarint number of functions
+//	{
+//		uvarint function name
+//		uvarint number of sites
+//		{
+//			uvarint pc offset
+//			uvarint file
+//			uvarint line
+//			uvarint column
+//			uvarint class
+//			uvarint operation
+//			uvarint operand type
+//			uvarint result type
+//		} ...
+//	} ...
+//
+// where strings are prefixed by their uvarint-encoded length, and the
+// other fields refer to strings by their index.
+func (ctxt *Link) panikint() {
+	ldr := ctxt.loader
+
+	var strs []string
+	strIndex := make(map[string]uint64)
+	str := func(s string) uint64 {
+		i, ok := strIndex[s]
+		if !ok {
+			i = uint64(len(strs))
+			strIndex[s] = i
+			strs = append(strs, s)
+		}
+		return i
+	}
+
+	var funcs []byte
+	nfuncs := 0
+	for _, s := range ctxt.Textp {
+		if !ldr.SymType(s).IsText() {
+			continue
+		}
+		aux := ldr.ArithSitesSym(s)
+		if aux == 0 {
+			continue
+		}
+		var sites []byte
+		nsites := 0
+		for d := ldr.Data(aux); len(d) > 0; nsites++ {
+			uvarint := func() uint64 {
+				v, n := binary.Uvarint(d)
+				if n <= 0 {
+					Exitf("corrupt check sites for %s", ldr.SymName(s))
+				}
+				d = d[n:]
+				return v
+			}
+			stringIdx := func() uint64 {
+				n := uvarint()
+				if n > uint64(len(d)) {
+					Exitf("corrupt check sites for %s", ldr.SymName(s))
+				}
+				i := str(string(d[:n]))
+				d = d[n:]
+				return i
+			}
+			// pc offset, file, line and column,
+			// then class, operation and types.
+			sites = binary.AppendUvarint(sites, uvarint())
+			sites = binary.AppendUvarint(sites, stringIdx())
+			sites = binary.AppendUvarint(sites, uvarint())
+			sites = binary.AppendUvarint(sites, uvarint())
+			for range 4 {
+				sites = binary.AppendUvarint(sites, stringIdx())
+			}
+		}
+		funcs = binary.AppendUvarint(funcs, str(ldr.SymName(s)))
+		funcs = binary.AppendUvarint(funcs, uint64(nsites))
+		funcs = append(funcs, sites...)
+		nfuncs++
+	}
+	if nfuncs == 0 {
+		return
+	}
+
+	// The \xff is invalid UTF-8, as in go:buildinfo.
+	const prefix = "\xff Go panikint:"
+	data := make([]byte, 16)
+	copy(data, prefix)
+	data[len(prefix)] = 1 // version
+	data = binary.AppendUvarint(data, uint64(len(strs)))
+	for _, s := range strs {
+		data = appendString(data, s)
+	}
+	data = binary.AppendUvarint(data, uint64(nfuncs))
+	data = append(data, funcs...)
+	// MacOS linker gets very upset if the size is not a multiple of alignment.
+	for len(data)%16 != 0 {
+		data = append(data, 0)
+	}
+
+	s := ldr.CreateSymForUpdate("go:panikint", 0)
+	s.SetType(sym.SPANIKINT)
+	s.SetAlign(16)
+	s.SetData(data)
+	s.SetSize(int64(len(data)))
+
+	// Add reference to go:panikint from the rodata section,
+	// so that external linking with -Wl,--gc-sections does not
+	// delete the table.
+	sr := ldr.CreateSymForUpdate("go:panikint.ref", 0)
+	sr.SetType(sym.SRODATA)
+	sr.SetAlign(int32(ctxt.Arch.PtrSize))
+	sr.AddAddr(ctxt.Arch, s.Sym())
+}
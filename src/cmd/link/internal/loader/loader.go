@@ -1733,6 +1733,15 @@ func (l *Loader) SEHUnwindSym(fnSymIdx Sym) Sym {
 	return l.aux1(fnSymIdx, goobj.AuxSehUnwindInfo)
 }
 
+// ArithSitesSym returns the auxiliary symbol holding the go-panikint
+// check sites of a given function symbol.
+func (l *Loader) ArithSitesSym(fnSymIdx Sym) Sym {
+	if !l.SymType(fnSymIdx).IsText() {
+		log.Fatalf("error: non-function sym %d/%s t=%s passed to ArithSitesSym", fnSymIdx, l.SymName(fnSymIdx), l.SymType(fnSymIdx).String())
+	}
+	return l.aux1(fnSymIdx, goobj.AuxArithSites)
+}
+
 // GetFuncDwarfAuxSyms collects and returns the auxiliary DWARF
 // symbols associated with a given function symbol.  Prior to the
 // introduction of the loader, this was done purely using name
@@ -85,6 +85,7 @@ const (
 	SFirstWritable
 	SBUILDINFO          // debug/buildinfo data (why is this writable?).
 	SFIPSINFO           // go:fipsinfo aka crypto/internal/fips140/check.Linkinfo (why is this writable)?
+	SPANIKINT           // go-panikint check site table, read by debug/panikint.
 	SELFSECT            // .got.plt, .plt, .dynamic where appropriate.
 	SMACHO              // Used only for .llvmasm?
 	SWINDOWS            // Windows dynamic symbols.
@@ -34,58 +34,59 @@ func _() {
 	_ = x[SFirstWritable-23]
 	_ = x[SBUILDINFO-24]
 	_ = x[SFIPSINFO-25]
-	_ = x[SELFSECT-26]
-	_ = x[SMACHO-27]
-	_ = x[SWINDOWS-28]
-	_ = x[SMODULEDATA-29]
-	_ = x[SELFGOT-30]
-	_ = x[SMACHOGOT-31]
-	_ = x[SNOPTRDATA-32]
-	_ = x[SNOPTRDATAFIPSSTART-33]
-	_ = x[SNOPTRDATAFIPS-34]
-	_ = x[SNOPTRDATAFIPSEND-35]
-	_ = x[SNOPTRDATAEND-36]
-	_ = x[SINITARR-37]
-	_ = x[SDATA-38]
-	_ = x[SDATAFIPSSTART-39]
-	_ = x[SDATAFIPS-40]
-	_ = x[SDATAFIPSEND-41]
-	_ = x[SDATAEND-42]
-	_ = x[SXCOFFTOC-43]
-	_ = x[SBSS-44]
-	_ = x[SNOPTRBSS-45]
-	_ = x[SGCMASK-46]
-	_ = x[SLIBFUZZER_8BIT_COUNTER-47]
-	_ = x[SCOVERAGE_COUNTER-48]
-	_ = x[SCOVERAGE_AUXVAR-49]
-	_ = x[STLSBSS-50]
-	_ = x[SFirstUnallocated-51]
-	_ = x[SXREF-52]
-	_ = x[SMACHOSYMSTR-53]
-	_ = x[SMACHOSYMTAB-54]
-	_ = x[SMACHOINDIRECTPLT-55]
-	_ = x[SMACHOINDIRECTGOT-56]
-	_ = x[SDYNIMPORT-57]
-	_ = x[SHOSTOBJ-58]
-	_ = x[SUNDEFEXT-59]
-	_ = x[SDWARFSECT-60]
-	_ = x[SDWARFCUINFO-61]
-	_ = x[SDWARFCONST-62]
-	_ = x[SDWARFFCN-63]
-	_ = x[SDWARFABSFCN-64]
-	_ = x[SDWARFTYPE-65]
-	_ = x[SDWARFVAR-66]
-	_ = x[SDWARFRANGE-67]
-	_ = x[SDWARFLOC-68]
-	_ = x[SDWARFLINES-69]
-	_ = x[SDWARFADDR-70]
-	_ = x[SSEHUNWINDINFO-71]
-	_ = x[SSEHSECT-72]
+	_ = x[SPANIKINT-26]
+	_ = x[SELFSECT-27]
+	_ = x[SMACHO-28]
+	_ = x[SWINDOWS-29]
+	_ = x[SMODULEDATA-30]
+	_ = x[SELFGOT-31]
+	_ = x[SMACHOGOT-32]
+	_ = x[SNOPTRDATA-33]
+	_ = x[SNOPTRDATAFIPSSTART-34]
+	_ = x[SNOPTRDATAFIPS-35]
+	_ = x[SNOPTRDATAFIPSEND-36]
+	_ = x[SNOPTRDATAEND-37]
+	_ = x[SINITARR-38]
+	_ = x[SDATA-39]
+	_ = x[SDATAFIPSSTART-40]
+	_ = x[SDATAFIPS-41]
+	_ = x[SDATAFIPSEND-42]
+	_ = x[SDATAEND-43]
+	_ = x[SXCOFFTOC-44]
+	_ = x[SBSS-45]
+	_ = x[SNOPTRBSS-46]
+	_ = x[SGCMASK-47]
+	_ = x[SLIBFUZZER_8BIT_COUNTER-48]
+	_ = x[SCOVERAGE_COUNTER-49]
+	_ = x[SCOVERAGE_AUXVAR-50]
+	_ = x[STLSBSS-51]
+	_ = x[SFirstUnallocated-52]
+	_ = x[SXREF-53]
+	_ = x[SMACHOSYMSTR-54]
+	_ = x[SMACHOSYMTAB-55]
+	_ = x[SMACHOINDIRECTPLT-56]
+	_ = x[SMACHOINDIRECTGOT-57]
+	_ = x[SDYNIMPORT-58]
+	_ = x[SHOSTOBJ-59]
+	_ = x[SUNDEFEXT-60]
+	_ = x[SDWARFSECT-61]
+	_ = x[SDWARFCUINFO-62]
+	_ = x[SDWARFCONST-63]
+	_ = x[SDWARFFCN-64]
+	_ = x[SDWARFABSFCN-65]
+	_ = x[SDWARFTYPE-66]
+	_ = x[SDWARFVAR-67]
+	_ = x[SDWARFRANGE-68]
+	_ = x[SDWARFLOC-69]
+	_ = x[SDWARFLINES-70]
+	_ = x[SDWARFADDR-71]
+	_ = x[SSEHUNWINDINFO-72]
+	_ = x[SSEHSECT-73]
 }
 
-const _SymKind_name = "SxxxSTEXTSTEXTFIPSSTARTSTEXTFIPSSTEXTFIPSENDSTEXTENDSELFRXSECTSMACHOPLTSSTRINGSGOSTRINGSGCBITSSRODATASRODATAFIPSSTARTSRODATAFIPSSRODATAFIPSENDSRODATAENDSPCLNTABSELFROSECTSRODATARELROSTYPESGOFUNCSELFRELROSECTSMACHORELROSECTSFirstWritableSBUILDINFOSFIPSINFOSELFSECTSMACHOSWINDOWSSMODULEDATASELFGOTSMACHOGOTSNOPTRDATASNOPTRDATAFIPSSTARTSNOPTRDATAFIPSSNOPTRDATAFIPSENDSNOPTRDATAENDSINITARRSDATASDATAFIPSSTARTSDATAFIPSSDATAFIPSENDSDATAENDSXCOFFTOCSBSSSNOPTRBSSSGCMASKSLIBFUZZER_8BIT_COUNTERSCOVERAGE_COUNTERSCOVERAGE_AUXVARSTLSBSSSFirstUnallocatedSXREFSMACHOSYMSTRSMACHOSYMTABSMACHOINDIRECTPLTSMACHOINDIRECTGOTSDYNIMPORTSHOSTOBJSUNDEFEXTSDWARFSECTSDWARFCUINFOSDWARFCONSTSDWARFFCNSDWARFABSFCNSDWARFTYPESDWARFVARSDWARFRANGESDWARFLOCSDWARFLINESSDWARFADDRSSEHUNWINDINFOSSEHSECT"
+const _SymKind_name = "SxxxSTEXTSTEXTFIPSSTARTSTEXTFIPSSTEXTFIPSENDSTEXTENDSELFRXSECTSMACHOPLTSSTRINGSGOSTRINGSGCBITSSRODATASRODATAFIPSSTARTSRODATAFIPSSRODATAFIPSENDSRODATAENDSPCLNTABSELFROSECTSRODATARELROSTYPESGOFUNCSELFRELROSECTSMACHORELROSECTSFirstWritableSBUILDINFOSFIPSINFOSPANIKINTSELFSECTSMACHOSWINDOWSSMODULEDATASELFGOTSMACHOGOTSNOPTRDATASNOPTRDATAFIPSSTARTSNOPTRDATAFIPSSNOPTRDATAFIPSENDSNOPTRDATAENDSINITARRSDATASDATAFIPSSTARTSDATAFIPSSDATAFIPSENDSDATAENDSXCOFFTOCSBSSSNOPTRBSSSGCMASKSLIBFUZZER_8BIT_COUNTERSCOVERAGE_COUNTERSCOVERAGE_AUXVARSTLSBSSSFirstUnallocatedSXREFSMACHOSYMSTRSMACHOSYMTABSMACHOINDIRECTPLTSMACHOINDIRECTGOTSDYNIMPORTSHOSTOBJSUNDEFEXTSDWARFSECTSDWARFCUINFOSDWARFCONSTSDWARFFCNSDWARFABSFCNSDWARFTYPESDWARFVARSDWARFRANGESDWARFLOCSDWARFLINESSDWARFADDRSSEHUNWINDINFOSSEHSECT"
 
-var _SymKind_index = [...]uint16{0, 4, 9, 23, 32, 44, 52, 62, 71, 78, 87, 94, 101, 117, 128, 142, 152, 160, 170, 182, 187, 194, 207, 222, 236, 246, 255, 263, 269, 277, 288, 295, 304, 314, 333, 347, 364, 377, 385, 390, 404, 413, 425, 433, 442, 446, 455, 462, 485, 502, 518, 525, 542, 547, 559, 571, 588, 605, 615, 623, 632, 642, 654, 665, 674, 686, 696, 705, 716, 725, 736, 746, 760, 768}
+var _SymKind_index = [...]uint16{0, 4, 9, 23, 32, 44, 52, 62, 71, 78, 87, 94, 101, 117, 128, 142, 152, 160, 170, 182, 187, 194, 207, 222, 236, 246, 255, 264, 272, 278, 286, 297, 304, 313, 323, 342, 356, 373, 386, 394, 399, 413, 422, 434, 442, 451, 455, 464, 471, 494, 511, 527, 534, 551, 556, 568, 580, 597, 614, 624, 632, 641, 651, 663, 674, 683, 695, 705, 714, 725, 734, 745, 755, 769, 777}
 
 func (i SymKind) String() string {
 	if i >= SymKind(len(_SymKind_index)-1) {
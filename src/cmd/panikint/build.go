@@ -0,0 +1,319 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"text/tabwriter"
+)
+
+// A check is an integer check considered by the compiler, as reported
+// in its optimizer log (see cmd/compile/internal/ssa.checkoverflow).
+type check struct {
+	Pkg, File string
+	Line, Col int
+	Status    string // found, removed, suppressed or excluded
+	Class     string // check class, as named by the -panikint flag of the go command
+	Op        string // operation, such as "addition" or "conversion"
+	Type      string // operand type, and result type if different
+
+	inlined bool // reported in a function it was inlined into
+}
+
+var checkStatus = map[string]string{
+	"arithCheck":           "found",
+	"arithCheckRemoved":    "removed",
+	"arithCheckSuppressed": "suppressed",
+	"arithCheckExcluded":   "excluded",
+}
+
+// statusOrder orders the statuses of the copies of a check, from the
+// one that stands for the check to the one that stands for it least.
+var statusOrder = []string{"found", "removed", "suppressed", "excluded"}
+
+// goCmd returns the path of the go command.
+func goCmd() string {
+	// Usually run as "go tool panikint", in which case $GOROOT is set,
+	// in which case runtime.GOROOT() does exactly what we want.
+	return filepath.Join(runtime.GOROOT(), "bin", "go")
+}
+
+// splitArgs splits args into build flags and packages.
+func splitArgs(args []string) (flags, pkgs []string) {
+	i := 0
+	for i < len(args) && strings.HasPrefix(args[i], "-") {
+		if args[i] == "--" {
+			i++
+			break
+		}
+		if strings.HasPrefix(strings.TrimLeft(args[i], "-"), "gcflags") {
+			log.Fatalf("the -gcflags build flag cannot be used")
+		}
+		i++
+	}
+	return args[:i], args[i:]
+}
+
+// compile compiles the packages named by args with optimizer logging
+// and returns the checks the compiler considered in them, and their
+// suppression directives.
+func compile(args []string) ([]check, []*directive) {
+	flags, pkgs := splitArgs(args)
+
+	dir, err := os.MkdirTemp("", "panikint")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// A new log directory changes the compiler flags,
+	// so the packages are always compiled again.
+	logDir := filepath.Join(dir, "log")
+	buildArgs := []string{"build", "-o", filepath.Join(dir, "bin") + string(filepath.Separator)}
+	buildArgs = append(buildArgs, flags...)
+	buildArgs = append(buildArgs, "-gcflags=-json=0,"+logDir)
+	buildArgs = append(buildArgs, pkgs...)
+	cmd := exec.Command(goCmd(), buildArgs...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		log.Fatalf("go build failed: %v", err)
+	}
+
+	var checks []check
+	var dirs []*directive
+	err = filepath.WalkDir(logDir, func(path string, d fs.DirEntry, err error) error {
+		if errors.Is(err, fs.ErrNotExist) && path == logDir {
+			return fs.SkipDir // nothing was logged
+		}
+		if err != nil || d.IsDir() {
+			return err
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		c, ds, err := readLog(data)
+		if err != nil {
+			return fmt.Errorf("%s: %v", path, err)
+		}
+		checks = append(checks, c...)
+		dirs = append(dirs, ds...)
+		return nil
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// A check is reported for each copy of its function, as for the
+	// instantiations of a generic function and the call sites it is
+	// inlined at, all at the innermost position of the check.
+	// Count it once: as found if any copy of it is, and as part of the
+	// package it was not inlined into.
+	slices.SortFunc(checks, func(a, b check) int {
+		return cmp.Or(
+			strings.Compare(a.site(), b.site()),
+			cmp.Compare(slices.Index(statusOrder, a.Status), slices.Index(statusOrder, b.Status)),
+			compareBool(a.inlined, b.inlined),
+			strings.Compare(a.Pkg, b.Pkg),
+		)
+	})
+	checks = slices.CompactFunc(checks, func(a, b check) bool {
+		return a.site() == b.site()
+	})
+	slices.SortFunc(dirs, func(a, b *directive) int { return a.pos.compare(b.pos) })
+	return checks, dirs
+}
+
+// site identifies the operation checked by c in the source code.
+func (c *check) site() string {
+	return fmt.Sprintf("%s\x00%08d\x00%08d\x00%s\x00%s\x00%s", c.File, c.Line, c.Col, c.Class, c.Op, c.Type)
+}
+
+func compareBool(a, b bool) int {
+	switch {
+	case a == b:
+		return 0
+	case a:
+		return 1
+	}
+	return -1
+}
+
+// A position is a position in the source code, as in the optimizer log.
+type position struct {
+	File      string
+	Line, Col int
+}
+
+func (p position) compare(q position) int {
+	return cmp.Or(strings.Compare(p.File, q.File), cmp.Compare(p.Line, q.Line), cmp.Compare(p.Col, q.Col))
+}
+
+// logPosition and logLocation are the positions of the optimizer log.
+type logPosition struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type logLocation struct {
+	URI   string `json:"uri"`
+	Range struct {
+		Start logPosition `json:"start"`
+		End   logPosition `json:"end"`
+	} `json:"range"`
+}
+
+// uriPath returns the file name of the file URI uri.
+func uriPath(uri string) (string, error) {
+	u, err := url.Parse(uri)
+	if err != nil {
+		return "", err
+	}
+	return filepath.FromSlash(u.Path), nil
+}
+
+// readLog returns the checks and the suppression directives reported
+// in one file of the optimizer log (see cmd/compile/internal/logopt).
+func readLog(data []byte) ([]check, []*directive, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	var header struct {
+		Package string `json:"package"`
+		File    string `json:"file"`
+	}
+	if err := dec.Decode(&header); err != nil {
+		return nil, nil, err
+	}
+	var checks []check
+	var dirs []*directive
+	for {
+		var d struct {
+			Range struct {
+				Start logPosition `json:"start"`
+			} `json:"range"`
+			Code               string `json:"code"`
+			Message            string `json:"message"`
+			RelatedInformation []struct {
+				Location logLocation `json:"location"`
+				Message  string      `json:"message"`
+			} `json:"relatedInformation"`
+		}
+		if err := dec.Decode(&d); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, nil, err
+		}
+		pos := position{header.File, d.Range.Start.Line, d.Range.Start.Character}
+
+		if d.Code == "arithDirective" {
+			// The related information holds the source ranges the
+			// directive applies to, or the directive itself if it
+			// applies to the whole file.
+			dir := &directive{pos: pos, text: d.Message}
+			for _, r := range d.RelatedInformation {
+				switch r.Message {
+				case "file":
+					dir.file = true
+				case "scope":
+					start, end := r.Location.Range.Start, r.Location.Range.End
+					dir.scopes = append(dir.scopes, scope{
+						start: position{header.File, start.Line, start.Character},
+						end:   position{header.File, end.Line, end.Character},
+					})
+				}
+			}
+			dirs = append(dirs, dir)
+			continue
+		}
+
+		status, ok := checkStatus[d.Code]
+		if !ok {
+			continue
+		}
+		// The message describes the check, as in "overflow check on
+		// int8 addition" or "truncation check on int64 to int32 conversion".
+		class, rest, ok1 := strings.Cut(d.Message, " check on ")
+		i := strings.LastIndex(rest, " ")
+		if !ok1 || i < 0 {
+			return nil, nil, fmt.Errorf("unexpected check message %q", d.Message)
+		}
+		// A check inlined into another function is reported at the
+		// outermost call site, with the positions it was inlined from,
+		// outermost first, as related information.
+		inlined := false
+		for _, r := range d.RelatedInformation {
+			if r.Message != "inlineLoc" {
+				continue
+			}
+			file, err := uriPath(r.Location.URI)
+			if err != nil {
+				return nil, nil, err
+			}
+			start := r.Location.Range.Start
+			pos, inlined = position{file, start.Line, start.Character}, true
+		}
+		checks = append(checks, check{
+			Pkg:     header.Package,
+			File:    pos.File,
+			Line:    pos.Line,
+			Col:     pos.Col,
+			Status:  status,
+			Class:   class,
+			Op:      rest[i+1:],
+			Type:    rest[:i],
+			inlined: inlined,
+		})
+	}
+	return checks, dirs, nil
+}
+
+// stats prints the number of checks per package, class and type.
+func stats(args []string) {
+	type key struct{ pkg, class, typ string }
+	counts := make(map[key]map[string]int)
+	var keys []key
+	checks, _ := compile(args)
+	for _, c := range checks {
+		k := key{c.Pkg, c.Class, c.Type}
+		if counts[k] == nil {
+			counts[k] = make(map[string]int)
+			keys = append(keys, k)
+		}
+		counts[k][c.Status]++
+	}
+	slices.SortFunc(keys, func(a, b key) int {
+		if c := strings.Compare(a.pkg, b.pkg); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.class, b.class); c != 0 {
+			return c
+		}
+		return strings.Compare(a.typ, b.typ)
+	})
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "package\tclass\ttype\tfound\tremoved\tsuppressed\texcluded\n")
+	for _, k := range keys {
+		n := counts[k]
+		fmt.Fprintf(w, "%s\t%s\t%s\t%d\t%d\t%d\t%d\n", k.pkg, k.class, k.typ, n["found"], n["removed"], n["suppressed"], n["excluded"])
+	}
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+}
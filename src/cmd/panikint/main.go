@@ -0,0 +1,92 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Panikint inspects the integer checks inserted by go-panikint
+// into binaries and packages.
+//
+// Usage:
+//
+//	go tool panikint sites binary
+//	go tool panikint diff old new
+//	go tool panikint stats [build flags] [packages]
+//	go tool panikint suppressions [build flags] [packages]
+//
+// Sites lists the checks in a binary, as recorded by the linker in the
+// check site table: the address and function offset of each call to a
+// panic or report function, with the position, class and types of the
+// checked operation. The addresses of a binary without a symbol table,
+// such as one linked with -s, are 0.
+//
+// Diff lists the checks added to or removed from binary old in binary
+// new, ignoring their addresses. It exits with status 1 if there are
+// differences.
+//
+// Stats compiles the packages and prints, for each package, check
+// class and type, how many checks were inserted, removed by the
+// optimizer, suppressed by a directive and excluded from
+// instrumentation, as reported by the compiler.
+//
+// Suppressions compiles the packages and lists their //panikint:ignore
+// and //go:nooverflowcheck directives, with the number of checks each
+// of them suppresses. A directive that suppresses nothing no longer
+// sits next to an instrumented operation.
+//
+// Build flags are passed to go build and must be written in the
+// -flag=value form. The -gcflags flag is used by the tool and
+// cannot be given.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"cmd/internal/telemetry/counter"
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: go tool panikint sites binary\n")
+	fmt.Fprintf(os.Stderr, "       go tool panikint diff old new\n")
+	fmt.Fprintf(os.Stderr, "       go tool panikint stats [build flags] [packages]\n")
+	fmt.Fprintf(os.Stderr, "       go tool panikint suppressions [build flags] [packages]\n")
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("panikint: ")
+	counter.Open()
+
+	flag.Usage = usage
+	flag.Parse()
+	counter.Inc("panikint/invocations")
+	counter.CountFlags("panikint/flag:", *flag.CommandLine)
+
+	args := flag.Args()
+	if len(args) == 0 {
+		usage()
+	}
+	cmd, args := args[0], args[1:]
+	counter.Inc("panikint/subcommand:" + cmd)
+	switch cmd {
+	case "sites":
+		if len(args) != 1 {
+			usage()
+		}
+		sites(args[0])
+	case "diff":
+		if len(args) != 2 {
+			usage()
+		}
+		diff(args[0], args[1])
+	case "stats":
+		stats(args)
+	case "suppressions":
+		suppressions(args)
+	default:
+		fmt.Fprintf(os.Stderr, "panikint: unknown command %q\n", cmd)
+		usage()
+	}
+}
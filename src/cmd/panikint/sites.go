@@ -0,0 +1,109 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"debug/panikint"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+
+	"cmd/internal/objfile"
+)
+
+// readSites returns the check site table of the named binary.
+func readSites(name string) *panikint.Table {
+	f, err := objfile.Open(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	t, err := panikint.ReadFile(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// The table reader only knows about the symbol tables of some
+	// formats. Fill in the other addresses from the Go symbol table.
+	// A stripped binary has none, and its sites are listed without
+	// their addresses.
+	if i := slices.IndexFunc(t.Sites, func(s panikint.Site) bool { return s.PC == 0 }); i >= 0 {
+		syms, err := f.Symbols()
+		if err != nil {
+			return t
+		}
+		addrs := make(map[string]uint64)
+		for _, s := range syms {
+			if s.Code == 'T' || s.Code == 't' {
+				addrs[s.Name] = s.Addr
+			}
+		}
+		for i := range t.Sites {
+			s := &t.Sites[i]
+			if addr, ok := addrs[s.Func]; ok && s.PC == 0 {
+				s.PC = addr + s.Offset
+			}
+		}
+	}
+	return t
+}
+
+// sites lists the checks in the named binary.
+func sites(name string) {
+	t := readSites(name)
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, ' ', 0)
+	for i := range t.Sites {
+		s := &t.Sites[i]
+		fmt.Fprintf(w, "%#x\t%s+%#x\t%s:%d:%d\t%v\n", s.PC, s.Func, s.Offset, s.File, s.Line, s.Col, s)
+	}
+	if err := w.Flush(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// diff lists the checks added to or removed from binary old in binary new.
+func diff(oldName, newName string) {
+	// Sites are compared without their offsets,
+	// which change with any unrelated edit of the function.
+	key := func(s *panikint.Site) string {
+		return fmt.Sprintf("%s:%d:%d: %s: %v", s.File, s.Line, s.Col, s.Func, s)
+	}
+	count := func(t *panikint.Table) map[string]int {
+		m := make(map[string]int)
+		for i := range t.Sites {
+			m[key(&t.Sites[i])]++
+		}
+		return m
+	}
+	old, new := count(readSites(oldName)), count(readSites(newName))
+
+	var lines []string
+	for k, n := range old {
+		for range n - new[k] {
+			lines = append(lines, "- "+k)
+		}
+	}
+	for k, n := range new {
+		for range n - old[k] {
+			lines = append(lines, "+ "+k)
+		}
+	}
+	slices.SortFunc(lines, func(a, b string) int {
+		if c := strings.Compare(a[2:], b[2:]); c != 0 {
+			return c
+		}
+		return strings.Compare(a, b)
+	})
+	for _, l := range lines {
+		fmt.Println(l)
+	}
+	if len(lines) > 0 {
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// A directive is a //panikint:ignore or //go:nooverflowcheck directive,
+// as reported by the compiler in its optimizer log (see
+// cmd/compile/internal/ssagen.logArithDirective).
+type directive struct {
+	pos        position
+	text       string
+	file       bool // applies to the whole file
+	scopes     []scope
+	suppressed int // number of checks suppressed
+}
+
+// A scope is the source range of a statement or function declaration,
+// from its first to its last character.
+type scope struct {
+	start, end position
+}
+
+func (s scope) contains(p position) bool {
+	return s.start.compare(p) <= 0 && p.compare(s.end) <= 0
+}
+
+// ignoreKinds maps the check classes to the kind of //panikint:ignore
+// directive that suppresses them. The checks of other classes are
+// suppressed as the check of their operation is.
+var ignoreKinds = map[string]string{
+	"overflow":   "overflow",
+	"negation":   "overflow",
+	"shift":      "overflow",
+	"truncation": "truncation",
+	"floatconv":  "truncation",
+}
+
+// ignoreKind returns the kind of //panikint:ignore directive that
+// suppresses c.
+func (c *check) ignoreKind() string {
+	if kind, ok := ignoreKinds[c.Class]; ok {
+		return kind
+	}
+	if c.Op == "conversion" {
+		return "truncation"
+	}
+	return "overflow"
+}
+
+// suppresses reports whether d suppresses check c.
+func (d *directive) suppresses(c *check) bool {
+	if c.Status != "suppressed" || c.File != d.pos.File {
+		return false
+	}
+	kinds := []string{"overflow"} // //go:nooverflowcheck
+	if rest, ok := strings.CutPrefix(d.text, "//panikint:ignore"); ok {
+		kinds = strings.Fields(rest)
+	}
+	if !slices.Contains(kinds, c.ignoreKind()) {
+		return false
+	}
+	if d.file {
+		return true
+	}
+	for _, s := range d.scopes {
+		if s.contains(position{c.File, c.Line, c.Col}) {
+			return true
+		}
+	}
+	return false
+}
+
+// suppressions lists the suppression directives of the packages with
+// the number of checks each of them suppresses.
+func suppressions(args []string) {
+	checks, dirs := compile(args)
+	for _, d := range dirs {
+		for i := range checks {
+			if d.suppresses(&checks[i]) {
+				d.suppressed++
+			}
+		}
+	}
+
+	wd, _ := os.Getwd()
+	for _, d := range dirs {
+		name := d.pos.File
+		if rel, err := filepath.Rel(wd, name); err == nil && !strings.HasPrefix(rel, "..") {
+			name = rel
+		}
+		switch d.suppressed {
+		case 0:
+			fmt.Printf("%s:%d: %s: suppresses nothing\n", name, d.pos.Line, d.text)
+		case 1:
+			fmt.Printf("%s:%d: %s: suppresses 1 check\n", name, d.pos.Line, d.text)
+		default:
+			fmt.Printf("%s:%d: %s: suppresses %d checks\n", name, d.pos.Line, d.text, d.suppressed)
+		}
+	}
+}
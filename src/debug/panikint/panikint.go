@@ -0,0 +1,353 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package panikint provides access to the table of integer check sites
+// that the linker embeds in Go binaries. The table lists the calls to the
+// panic or report function of every overflow, truncation or other integer
+// check inserted by the compiler and kept by the optimizer, so that a
+// panic can be mapped back to the check that failed.
+//
+// Binaries in which no check was inserted have no table.
+// The ELF, Mach-O and PE formats are supported.
+package panikint
+
+import (
+	"bytes"
+	"debug/elf"
+	"debug/macho"
+	"debug/pe"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+)
+
+// A Site is a call to the panic or report function of a check.
+type Site struct {
+	Func   string // function containing the call, as in runtime.Func.Name
+	Offset uint64 // offset of the call instruction from the start of Func
+	PC     uint64 // address of the call instruction, or 0 if the address of Func is unknown
+	File   string // source position of the check, in the innermost inlined function
+	Line   int
+	Col    int
+	Class  string // check class, as named by the -panikint flag of the go command
+	Op     string // operation, such as "addition" or "conversion"
+	Src    string // operand type
+	Dst    string // result type
+}
+
+// String describes the check, as in "overflow check on int8 addition"
+// or "truncation check on int64 to int32 conversion".
+func (s *Site) String() string {
+	if s.Src != s.Dst {
+		return fmt.Sprintf("%s check on %s to %s %s", s.Class, s.Src, s.Dst, s.Op)
+	}
+	return fmt.Sprintf("%s check on %s %s", s.Class, s.Src, s.Op)
+}
+
+// A Table is the check site table of a binary.
+type Table struct {
+	// Sites holds the check sites,
+	// sorted by function name and offset.
+	Sites []Site
+}
+
+// maxCallLen is an upper bound on the length of a call instruction,
+// over all architectures.
+const maxCallLen = 16
+
+// LookupFunc returns the site whose call returns to the given offset
+// in the function named fn, or nil if there is none. Tracebacks print
+// such offsets as, for example, "+0x1c".
+func (t *Table) LookupFunc(fn string, off uint64) *Site {
+	i := sort.Search(len(t.Sites), func(i int) bool {
+		s := &t.Sites[i]
+		return s.Func > fn || s.Func == fn && s.Offset >= off
+	})
+	if i == 0 {
+		return nil
+	}
+	s := &t.Sites[i-1]
+	if s.Func != fn || off-s.Offset > maxCallLen {
+		return nil
+	}
+	return s
+}
+
+// Lookup returns the site whose call returns to the address pc,
+// or nil if there is none. Only sites with a known PC are considered.
+func (t *Table) Lookup(pc uint64) *Site {
+	var best *Site
+	for i := range t.Sites {
+		s := &t.Sites[i]
+		if s.PC != 0 && s.PC < pc && pc-s.PC <= maxCallLen && (best == nil || s.PC > best.PC) {
+			best = s
+		}
+	}
+	return best
+}
+
+var (
+	errUnrecognizedFormat = errors.New("unrecognized file format")
+	errNoTable            = errors.New("no check site table")
+	errMalformed          = errors.New("malformed check site table")
+)
+
+// The table starts with a 16-byte header, consisting of tableMagic
+// (14 bytes), a version byte and a padding byte.
+// See cmd/link/internal/ld.Link.panikint for the format.
+var tableMagic = []byte("\xff Go panikint:")
+
+const (
+	tableAlign      = 16
+	tableHeaderSize = 16
+	tableVersion    = 1
+)
+
+// ReadFile returns the check site table embedded in the Go binary
+// file at the given path.
+func ReadFile(name string) (t *Table, err error) {
+	defer func() {
+		if _, ok := errors.AsType[*fs.PathError](err); ok {
+			err = fmt.Errorf("could not read check site table: %w", err)
+		} else if err != nil {
+			err = fmt.Errorf("could not read check site table from %s: %w", name, err)
+		}
+	}()
+
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// Read returns the check site table embedded in a Go binary
+// file accessed through the given ReaderAt.
+func Read(r io.ReaderAt) (*Table, error) {
+	ident := make([]byte, 16)
+	if n, err := r.ReadAt(ident, 0); n < len(ident) || err != nil {
+		return nil, errUnrecognizedFormat
+	}
+
+	var (
+		data  []byte
+		addrs map[string]uint64
+		err   error
+	)
+	switch {
+	case bytes.HasPrefix(ident, []byte("\x7FELF")):
+		f, ferr := elf.NewFile(r)
+		if ferr != nil {
+			return nil, errUnrecognizedFormat
+		}
+		data, addrs, err = readELF(f)
+	case bytes.HasPrefix(ident, []byte("MZ")):
+		f, ferr := pe.NewFile(r)
+		if ferr != nil {
+			return nil, errUnrecognizedFormat
+		}
+		data, addrs, err = readPE(f)
+	case bytes.HasPrefix(ident, []byte("\xFE\xED\xFA")) || bytes.HasPrefix(ident[1:], []byte("\xFA\xED\xFE")):
+		f, ferr := macho.NewFile(r)
+		if ferr != nil {
+			return nil, errUnrecognizedFormat
+		}
+		data, addrs, err = readMachO(f)
+	default:
+		return nil, errUnrecognizedFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := parse(data)
+	if err != nil {
+		return nil, err
+	}
+	for i := range t.Sites {
+		s := &t.Sites[i]
+		if addr, ok := addrs[s.Func]; ok {
+			s.PC = addr + s.Offset
+		}
+	}
+	return t, nil
+}
+
+func readELF(f *elf.File) ([]byte, map[string]uint64, error) {
+	sect := f.Section(".go.panikint")
+	if sect == nil {
+		return nil, nil, errNoTable
+	}
+	data, err := sect.Data()
+	if err != nil {
+		return nil, nil, err
+	}
+	addrs := make(map[string]uint64)
+	syms, _ := f.Symbols()
+	for _, s := range syms {
+		if elf.ST_TYPE(s.Info) == elf.STT_FUNC {
+			addrs[s.Name] = s.Value
+		}
+	}
+	return data, addrs, nil
+}
+
+func readMachO(f *macho.File) ([]byte, map[string]uint64, error) {
+	sect := f.Section("__go_panikint")
+	if sect == nil {
+		return nil, nil, errNoTable
+	}
+	data, err := sect.Data()
+	if err != nil {
+		return nil, nil, err
+	}
+	addrs := make(map[string]uint64)
+	if f.Symtab != nil {
+		for _, s := range f.Symtab.Syms {
+			// Symbol names may have the "_" prefix
+			// of the system toolchain.
+			addrs[s.Name] = s.Value
+			if name, ok := strings.CutPrefix(s.Name, "_"); ok {
+				if _, dup := addrs[name]; !dup {
+					addrs[name] = s.Value
+				}
+			}
+		}
+	}
+	return data, addrs, nil
+}
+
+func readPE(f *pe.File) ([]byte, map[string]uint64, error) {
+	var imageBase uint64
+	switch oh := f.OptionalHeader.(type) {
+	case *pe.OptionalHeader32:
+		imageBase = uint64(oh.ImageBase)
+	case *pe.OptionalHeader64:
+		imageBase = oh.ImageBase
+	}
+
+	// The table is in the data section, next to the build info.
+	const (
+		IMAGE_SCN_CNT_INITIALIZED_DATA = 0x00000040
+		IMAGE_SCN_MEM_READ             = 0x40000000
+		IMAGE_SCN_MEM_WRITE            = 0x80000000
+		IMAGE_SCN_ALIGN_32BYTES        = 0x600000
+	)
+	var data []byte
+	for _, sect := range f.Sections {
+		if sect.VirtualAddress == 0 || sect.Size == 0 ||
+			sect.Characteristics&^IMAGE_SCN_ALIGN_32BYTES != IMAGE_SCN_CNT_INITIALIZED_DATA|IMAGE_SCN_MEM_READ|IMAGE_SCN_MEM_WRITE {
+			continue
+		}
+		b, err := sect.Data()
+		if err != nil {
+			return nil, nil, err
+		}
+		for i := 0; ; {
+			j := bytes.Index(b[i:], tableMagic)
+			if j < 0 {
+				break
+			}
+			if (i+j)%tableAlign == 0 {
+				data = b[i+j:]
+				break
+			}
+			i += j + 1
+		}
+		break
+	}
+	if data == nil {
+		return nil, nil, errNoTable
+	}
+
+	addrs := make(map[string]uint64)
+	for _, s := range f.Symbols {
+		if s.SectionNumber <= 0 || int(s.SectionNumber) > len(f.Sections) {
+			continue
+		}
+		sect := f.Sections[s.SectionNumber-1]
+		addrs[s.Name] = imageBase + uint64(sect.VirtualAddress) + uint64(s.Value)
+	}
+	return data, addrs, nil
+}
+
+// parse decodes the table at the start of data.
+func parse(data []byte) (*Table, error) {
+	if len(data) < tableHeaderSize || !bytes.HasPrefix(data, tableMagic) {
+		return nil, errNoTable
+	}
+	if v := data[len(tableMagic)]; v != tableVersion {
+		return nil, fmt.Errorf("unsupported check site table version %d", v)
+	}
+	d := data[tableHeaderSize:]
+
+	var err error
+	uvarint := func() uint64 {
+		v, n := binary.Uvarint(d)
+		if n <= 0 {
+			err = errMalformed
+			d = nil
+			return 0
+		}
+		d = d[n:]
+		return v
+	}
+
+	nstrs := uvarint()
+	if nstrs > uint64(len(d)) {
+		return nil, errMalformed
+	}
+	strs := make([]string, nstrs)
+	for i := range strs {
+		n := uvarint()
+		if n > uint64(len(d)) {
+			return nil, errMalformed
+		}
+		strs[i] = string(d[:n])
+		d = d[n:]
+	}
+	str := func() string {
+		i := uvarint()
+		if i >= uint64(len(strs)) {
+			err = errMalformed
+			return ""
+		}
+		return strs[i]
+	}
+
+	t := new(Table)
+	for nfuncs := uvarint(); nfuncs > 0 && err == nil; nfuncs-- {
+		fn := str()
+		for nsites := uvarint(); nsites > 0 && err == nil; nsites-- {
+			t.Sites = append(t.Sites, Site{
+				Func:   fn,
+				Offset: uvarint(),
+				File:   str(),
+				Line:   int(uvarint()),
+				Col:    int(uvarint()),
+				Class:  str(),
+				Op:     str(),
+				Src:    str(),
+				Dst:    str(),
+			})
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(t.Sites, func(i, j int) bool {
+		a, b := &t.Sites[i], &t.Sites[j]
+		if a.Func != b.Func {
+			return a.Func < b.Func
+		}
+		return a.Offset < b.Offset
+	})
+	return t, nil
+}
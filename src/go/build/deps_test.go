@@ -62,6 +62,7 @@ var depsRules = `
 	  internal/trace/tracev2,
 	  internal/trace/traceviewer/format,
 	  log/internal,
+	  math/arith,
 	  math/bits,
 	  structs,
 	  unicode,
@@ -125,6 +126,9 @@ var depsRules = `
 	internal/oserror, maps, slices
 	< RUNTIME;
 
+	RUNTIME
+	< runtime/panikint;
+
 	RUNTIME
 	< sort
 	< container/heap
@@ -332,7 +336,7 @@ var depsRules = `
 	< runtime/debug
 	< debug/dwarf
 	< debug/elf, debug/gosym, debug/macho, debug/pe, debug/plan9obj, internal/xcoff
-	< debug/buildinfo
+	< debug/buildinfo, debug/panikint
 	< DEBUG;
 
 	# go parser and friends.
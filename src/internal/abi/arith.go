@@ -0,0 +1,63 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package abi
+
+// This type and constants are for encoding the operation
+// whose result failed an integer overflow or truncation check.
+type ArithOp uint8
+
+const (
+	ArithAdd  ArithOp = iota // x + y does not fit in the operand type
+	ArithSub                 // x - y does not fit in the operand type
+	ArithMul                 // x * y does not fit in the operand type
+	ArithDiv                 // x / y does not fit in the operand type
+	ArithConv                // T(x) does not fit in the destination type
+	ArithNeg                 // -x does not fit in the operand type
+	ArithShl                 // x << y does not fit in the operand type
+
+	// The results of these operations always fit in the operand type.
+	// They are only checked against the range declared for that type.
+	ArithMod    // x % y
+	ArithShr    // x >> y
+	ArithAnd    // x & y
+	ArithOr     // x | y
+	ArithXor    // x ^ y
+	ArithAndNot // x &^ y
+	ArithNot    // ^x
+	numArithOps
+
+	// ArithInt32 is set in the operation of the checks inserted on 64-bit
+	// targets by the 32-bit portability mode, which check that int and
+	// uint values fit in 32 bits.
+	ArithInt32 ArithOp = 1 << 4
+)
+
+// Here's how we encode arithmetic check failures:
+//
+//	bits    use
+//	-----------------------------
+//	[0:4]   operation, with ArithInt32
+//	[5:9]   kind of the operands
+//	[10:14] kind of the result
+//	[15:30] column of the operation, or ArithMaxColumn if larger
+//
+// The column distinguishes checks on the same line from each other.
+// It is limited to 16 bits so that the encoding fits in a 32-bit int.
+
+const ArithMaxColumn = 1<<16 - 1
+
+// ArithEncode encodes an arithmetic check failure into the integer
+// passed to the runtime's overflow and truncation panic functions.
+// src is the kind of the operands and dst the kind of the result;
+// they differ only for conversions.
+func ArithEncode(op ArithOp, src, dst Kind, col int) int {
+	col = min(col, ArithMaxColumn)
+	return int(op) | int(src)<<5 | int(dst)<<10 | col<<15
+}
+
+// ArithDecode is the inverse of ArithEncode.
+func ArithDecode(v int) (op ArithOp, src, dst Kind, col int) {
+	return ArithOp(v & 0x1f), Kind(v >> 5 & 0x1f), Kind(v >> 10 & 0x1f), v >> 15 & ArithMaxColumn
+}
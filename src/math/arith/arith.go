@@ -0,0 +1,134 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package arith implements checked, wrapping and saturating arithmetic
+// on integers of any type.
+//
+// The Wrapping functions compute their result modulo 2ⁿ, like the Go
+// operators do, but are never checked for overflow, even in builds that
+// instrument arithmetic. They mark the wrapping arithmetic of hash
+// functions, checksums and sequence numbers as intentional. The Checked
+// functions report whether the result overflowed, and the Saturating
+// functions clamp it to the range of its type.
+//
+// On amd64, arm64 and riscv64, the compiler implements the Checked and
+// Saturating functions with the same flag-based sequences as the
+// instrumentation, and the Wrapping functions everywhere with a single
+// instruction.
+package arith
+
+import "unsafe"
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// WrappingAdd returns x + y, wrapped around on overflow.
+//
+//go:nooverflowcheck
+func WrappingAdd[T Integer](x, y T) T {
+	return x + y
+}
+
+// WrappingSub returns x - y, wrapped around on overflow.
+//
+//go:nooverflowcheck
+func WrappingSub[T Integer](x, y T) T {
+	return x - y
+}
+
+// WrappingMul returns x * y, wrapped around on overflow.
+//
+//go:nooverflowcheck
+func WrappingMul[T Integer](x, y T) T {
+	return x * y
+}
+
+// CheckedAdd returns x + y, wrapped around on overflow, and whether
+// the sum fits in T.
+//
+//go:nooverflowcheck
+func CheckedAdd[T Integer](x, y T) (sum T, ok bool) {
+	sum = x + y
+	if signed[T]() {
+		return sum, (sum > x) == (y > 0)
+	}
+	return sum, sum >= x
+}
+
+// CheckedSub returns x - y, wrapped around on overflow, and whether
+// the difference fits in T.
+//
+//go:nooverflowcheck
+func CheckedSub[T Integer](x, y T) (diff T, ok bool) {
+	diff = x - y
+	if signed[T]() {
+		return diff, (diff < x) == (y > 0)
+	}
+	return diff, x >= y
+}
+
+// CheckedMul returns x * y, wrapped around on overflow, and whether
+// the product fits in T.
+//
+//go:nooverflowcheck
+func CheckedMul[T Integer](x, y T) (prod T, ok bool) {
+	prod = x * y
+	switch {
+	case x == 0 || y == 0:
+		return prod, true
+	case signed[T]() && y == ^T(0):
+		// Only the negation of the minimum value overflows,
+		// which the division below does not tell.
+		return prod, prod != x
+	}
+	return prod, prod/y == x
+}
+
+// SaturatingAdd returns x + y, or the nearest value of T on overflow.
+func SaturatingAdd[T Integer](x, y T) T {
+	sum, ok := CheckedAdd(x, y)
+	if !ok {
+		return limit[T](y > 0)
+	}
+	return sum
+}
+
+// SaturatingSub returns x - y, or the nearest value of T on overflow.
+func SaturatingSub[T Integer](x, y T) T {
+	diff, ok := CheckedSub(x, y)
+	if !ok {
+		return limit[T](y < 0)
+	}
+	return diff
+}
+
+// SaturatingMul returns x * y, or the nearest value of T on overflow.
+func SaturatingMul[T Integer](x, y T) T {
+	prod, ok := CheckedMul(x, y)
+	if !ok {
+		return limit[T]((x < 0) == (y < 0))
+	}
+	return prod
+}
+
+// signed reports whether T is a signed integer type.
+func signed[T Integer]() bool {
+	return ^T(0) < 0
+}
+
+// limit returns the maximum value of T if upper is set,
+// and its minimum value otherwise.
+func limit[T Integer](upper bool) T {
+	hi := ^T(0)
+	if hi < 0 {
+		hi = T(^uint64(0) >> (65 - 8*unsafe.Sizeof(hi)))
+	}
+	if !upper {
+		return ^hi
+	}
+	return hi
+}
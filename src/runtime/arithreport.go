@@ -0,0 +1,187 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package runtime
+
+import (
+	"internal/abi"
+	"internal/runtime/atomic"
+	"internal/runtime/sys"
+	"unsafe"
+)
+
+// Code compiled with -gcflags=-arithrecover calls reportoverflowdetailed,
+// reporttruncatedetailed, reportfloatconvdetailed, their named variants
+// or reportrange instead of panicking when an integer overflow,
+// truncation, float conversion or range check fails, and then continues
+// with the wrapped result.
+// Each failing check is reported once, with its position and stack, to
+// standard error or, if the GOPANIKINT_REPORT environment variable names
+// a file, to that file. The file is truncated when the first report is
+// made.
+
+// reportoverflowdetailed reports that x op y does not fit in the operand
+// type. code is an abi.ArithEncode encoding of the operation, its type
+// and its column.
+func reportoverflowdetailed(x, y uint64, code int) {
+	op, src, dst, col := abi.ArithDecode(code)
+	reportArithmeticError(newArithmeticError(x, y, op, src, dst, sys.GetCallerPC(), col))
+}
+
+// reporttruncatedetailed reports that the conversion of x does not fit in
+// the destination type. code is an abi.ArithEncode encoding of the
+// conversion, its source and destination types and its column.
+func reporttruncatedetailed(x uint64, code int) {
+	op, src, dst, col := abi.ArithDecode(code)
+	reportArithmeticError(newArithmeticError(x, 0, op, src, dst, sys.GetCallerPC(), col))
+}
+
+// reportfloatconvdetailed reports that the float x, converted to an
+// integer type, is NaN or out of range. code is an abi.ArithEncode encoding
+// of the conversion, its source and destination types and its column.
+func reportfloatconvdetailed(x float64, code int) {
+	op, src, dst, col := abi.ArithDecode(code)
+	reportArithmeticError(newArithmeticError(float64bits(x), 0, op, src, dst, sys.GetCallerPC(), col))
+}
+
+// reportarithnamed is reportoverflowdetailed and reporttruncatedetailed
+// for the checks whose source or destination type is a defined type with
+// //panikint: directives, named by src and dst as for panicarithnamed.
+func reportarithnamed(x, y uint64, code int, src, dst string) {
+	op, srcKind, dstKind, col := abi.ArithDecode(code)
+	e := newArithmeticError(x, y, op, srcKind, dstKind, sys.GetCallerPC(), col)
+	e.srcName, e.dstName = src, dst
+	reportArithmeticError(e)
+}
+
+// reportfloatconvnamed is reportfloatconvdetailed for the conversions to
+// a defined type with //panikint: directives, whose name is dst.
+func reportfloatconvnamed(x float64, code int, dst string) {
+	op, src, dstKind, col := abi.ArithDecode(code)
+	e := newArithmeticError(float64bits(x), 0, op, src, dstKind, sys.GetCallerPC(), col)
+	e.dstName = dst
+	reportArithmeticError(e)
+}
+
+// reportrange reports that x lies outside the range lo..hi declared for
+// the type named dst, as for panicrange.
+func reportrange(x, lo, hi uint64, code int, dst string) {
+	op, src, dstKind, col := abi.ArithDecode(code)
+	reportArithmeticError(newRangeError(x, lo, hi, op, src, dstKind, dst, sys.GetCallerPC(), col))
+}
+
+// reportrangefloat is reportrange for the conversions of the float x.
+func reportrangefloat(x float64, lo, hi uint64, code int, dst string) {
+	op, src, dstKind, col := abi.ArithDecode(code)
+	reportArithmeticError(newRangeError(float64bits(x), lo, hi, op, src, dstKind, dst, sys.GetCallerPC(), col))
+}
+
+// arithReportedSites is an open-addressed set of the PCs of the checks
+// that have already been reported.
+var arithReportedSites [4096]atomic.Uintptr
+
+// firstArithReport records pc in arithReportedSites and reports whether
+// it was not there yet. Once the set is full, every report is a first one.
+func firstArithReport(pc uintptr) bool {
+	n := uintptr(len(arithReportedSites))
+	h := pc ^ pc>>12
+	for i := uintptr(0); i < n; i++ {
+		slot := &arithReportedSites[(h+i)%n]
+		old := slot.Load()
+		if old == 0 {
+			if slot.CompareAndSwap(0, pc) {
+				return true
+			}
+			old = slot.Load()
+		}
+		if old == pc {
+			return false
+		}
+	}
+	return true
+}
+
+const (
+	arithReportUnopened = iota
+	arithReportOpening
+	arithReportOpen
+)
+
+var (
+	arithReportState atomic.Uint32
+	arithReportFD    uintptr
+)
+
+// arithReportOutput returns the file descriptor reports are written to,
+// creating the file named by GOPANIKINT_REPORT on first use.
+func arithReportOutput() uintptr {
+	for {
+		switch arithReportState.Load() {
+		case arithReportOpen:
+			return arithReportFD
+		case arithReportUnopened:
+			if !arithReportState.CompareAndSwap(arithReportUnopened, arithReportOpening) {
+				continue
+			}
+			fd := uintptr(2)
+			if name := gogetenv("GOPANIKINT_REPORT"); name != "" && canCreateFile {
+				path := append([]byte(name), 0)
+				if f := create(&path[0], 0o644); f >= 0 {
+					fd = uintptr(f)
+				}
+			}
+			arithReportFD = fd
+			arithReportState.Store(arithReportOpen)
+		default:
+			osyield()
+		}
+	}
+}
+
+// reportArithmeticError writes e, the position of the failed check and the
+// stack of the calling goroutine to the report output, unless the check has
+// already been reported.
+func reportArithmeticError(e *ArithmeticError) {
+	if !firstArithReport(e.pc) {
+		return
+	}
+	// Skip reportArithmeticError and the report function
+	// so that the first frame is the failed check.
+	pcs := make([]uintptr, 32)
+	n := callers(2, pcs)
+	frames := CallersFrames(pcs[:n])
+
+	b := make([]byte, 0, 1024)
+	b = append(b, "panikint: integer "...)
+	b = append(b, e.kind.String()...)
+	first, more := frames.Next()
+	if first.File != "" {
+		b = append(b, " at "...)
+		b = appendFileLine(b, first.File, first.Line)
+		if e.col > 0 {
+			b = append(b, ':')
+			b = appendIntStr(b, int64(e.col), false)
+		}
+	}
+	b = append(b, ": "...)
+	b = append(b, e.Error()[len("runtime error: "):]...)
+	b = append(b, '\n')
+	for frame := first; ; frame, more = frames.Next() {
+		b = append(b, frame.Function...)
+		b = append(b, "\n\t"...)
+		b = appendFileLine(b, frame.File, frame.Line)
+		b = append(b, '\n')
+		if !more {
+			break
+		}
+	}
+	b = append(b, '\n')
+	write(arithReportOutput(), unsafe.Pointer(&b[0]), int32(len(b)))
+}
+
+func appendFileLine(b []byte, file string, line int) []byte {
+	b = append(b, file...)
+	b = append(b, ':')
+	return appendIntStr(b, int64(line), false)
+}
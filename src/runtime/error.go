@@ -7,7 +7,9 @@ package runtime
 import (
 	"internal/abi"
 	"internal/bytealg"
+	"internal/goarch"
 	"internal/runtime/sys"
+	"internal/strconv"
 )
 
 // Error identifies a runtime error used in panic.
@@ -200,6 +202,487 @@ func (e boundsError) Error() string {
 	return string(b)
 }
 
+// An ArithmeticKind classifies the failure reported by an ArithmeticError.
+type ArithmeticKind uint8
+
+const (
+	// ArithmeticOverflow reports a result above the maximum value of its type.
+	ArithmeticOverflow ArithmeticKind = iota + 1
+	// ArithmeticUnderflow reports a result below the minimum value of its type.
+	ArithmeticUnderflow
+	// ArithmeticDivisionOverflow reports the division of the minimum value
+	// of a signed type by -1.
+	ArithmeticDivisionOverflow
+	// ArithmeticTruncation reports a conversion that loses significant bits.
+	ArithmeticTruncation
+	// ArithmeticSignChange reports a conversion that keeps every bit of
+	// the value but reinterprets its sign.
+	ArithmeticSignChange
+	// ArithmeticOutOfRange reports the conversion to an integer type of
+	// a float that is NaN, infinite or outside the range of that type.
+	ArithmeticOutOfRange
+	// ArithmeticRangeViolation reports a value outside the range declared
+	// for its type by a //panikint:range directive.
+	ArithmeticRangeViolation
+)
+
+var arithmeticKindNames = [...]string{
+	ArithmeticOverflow:         "overflow",
+	ArithmeticUnderflow:        "underflow",
+	ArithmeticDivisionOverflow: "division overflow",
+	ArithmeticTruncation:       "truncation",
+	ArithmeticSignChange:       "sign change",
+	ArithmeticOutOfRange:       "out of range",
+	ArithmeticRangeViolation:   "range violation",
+}
+
+func (k ArithmeticKind) String() string {
+	if int(k) < len(arithmeticKindNames) && arithmeticKindNames[k] != "" {
+		return arithmeticKindNames[k]
+	}
+	return "ArithmeticKind(" + string(itoa(make([]byte, 20), uint64(k))) + ")"
+}
+
+// An arithmeticKindError is the type of the sentinel errors
+// matched by ArithmeticError.Is.
+type arithmeticKindError ArithmeticKind
+
+func (e arithmeticKindError) RuntimeError() {}
+
+func (e arithmeticKindError) Error() string {
+	return "runtime error: integer " + ArithmeticKind(e).String()
+}
+
+// Sentinel errors for use with [errors.Is]. Each one matches the
+// [*ArithmeticError] values of the corresponding [ArithmeticKind].
+var (
+	ErrOverflow         error = arithmeticKindError(ArithmeticOverflow)
+	ErrUnderflow        error = arithmeticKindError(ArithmeticUnderflow)
+	ErrDivisionOverflow error = arithmeticKindError(ArithmeticDivisionOverflow)
+	ErrTruncation       error = arithmeticKindError(ArithmeticTruncation)
+	ErrSignChange       error = arithmeticKindError(ArithmeticSignChange)
+	ErrOutOfRange       error = arithmeticKindError(ArithmeticOutOfRange)
+	ErrRangeViolation   error = arithmeticKindError(ArithmeticRangeViolation)
+)
+
+// An ArithmeticError describes an integer operation or conversion whose
+// result does not fit in its type, or in the range declared for its type.
+// Programs built with integer overflow, truncation and float conversion
+// checks, or using types with //panikint:range directives, panic with an
+// *ArithmeticError when a check fails.
+type ArithmeticError struct {
+	// Operands of the failed operation, sign or zero extended to 64 bits
+	// according to src. Conversions and negations only use x.
+	// For shifts, y is the shift count, zero extended.
+	// For float conversions, x holds the bits of the float64 value.
+	x, y uint64
+	op   abi.ArithOp
+	src  abi.Kind // kind of the operands
+	dst  abi.Kind // kind of the result
+	kind ArithmeticKind
+	pc   uintptr
+	col  int // column of the operation on the line of pc
+
+	// bits32 is set for the checks of the 32-bit portability mode,
+	// whose int and uint results must fit in 32 bits.
+	bits32 bool
+
+	// srcName and dstName name the source and destination types if
+	// they are defined types with //panikint: directives.
+	srcName, dstName string
+
+	// For range violations, x is the result of the operation, or the
+	// operand of the conversion, and lo and hi are the bounds of the
+	// range declared for the type, extended to 64 bits according to dst.
+	lo, hi uint64
+}
+
+// newArithmeticError returns the error for the failed operation op on x and
+// y at column col, whose check called into the runtime with return address pc.
+func newArithmeticError(x, y uint64, op abi.ArithOp, src, dst abi.Kind, pc uintptr, col int) *ArithmeticError {
+	e := &ArithmeticError{x: x, y: y, op: op &^ abi.ArithInt32, src: src, dst: dst, pc: pc, col: col}
+	e.bits32 = op&abi.ArithInt32 != 0
+	op = e.op
+	neg := kindSigned(src) && int64(x) < 0
+	switch op {
+	case abi.ArithAdd:
+		e.kind = ArithmeticOverflow
+		if neg {
+			e.kind = ArithmeticUnderflow
+		}
+	case abi.ArithSub:
+		e.kind = ArithmeticOverflow
+		if neg || !kindSigned(src) {
+			e.kind = ArithmeticUnderflow
+		}
+	case abi.ArithMul:
+		e.kind = ArithmeticOverflow
+		if neg != (kindSigned(src) && int64(y) < 0) {
+			e.kind = ArithmeticUnderflow
+		}
+	case abi.ArithShl:
+		e.kind = ArithmeticOverflow
+		if neg {
+			e.kind = ArithmeticUnderflow
+		}
+	case abi.ArithDiv:
+		e.kind = ArithmeticDivisionOverflow
+	case abi.ArithNeg:
+		e.kind = ArithmeticOverflow
+		if !kindSigned(src) {
+			e.kind = ArithmeticUnderflow
+		}
+	case abi.ArithConv:
+		e.kind = ArithmeticTruncation
+		if kindFloat(src) {
+			e.kind = ArithmeticOutOfRange
+		} else if kindWrap(e.result(), src) == x {
+			e.kind = ArithmeticSignChange
+		}
+	}
+	return e
+}
+
+// newRangeError returns the error for x, the result of the operation op
+// at column col or the operand of the conversion, which lies outside the
+// range lo..hi declared for the defined type named dstName. src is the
+// kind of the operands of op.
+func newRangeError(x, lo, hi uint64, op abi.ArithOp, src, dst abi.Kind, dstName string, pc uintptr, col int) *ArithmeticError {
+	e := &ArithmeticError{x: x, op: op, src: src, dst: dst, kind: ArithmeticRangeViolation, pc: pc, col: col, dstName: dstName, lo: lo, hi: hi}
+	if op != abi.ArithConv {
+		e.srcName = dstName
+	}
+	return e
+}
+
+// arithOpNames and arithOpSymbols describe the operations
+// in ArithmeticError.Error below.
+var arithOpNames = [...]string{
+	abi.ArithAdd:  "addition",
+	abi.ArithSub:  "subtraction",
+	abi.ArithMul:  "multiplication",
+	abi.ArithDiv:  "division",
+	abi.ArithConv: "conversion",
+	abi.ArithNeg:  "negation",
+	abi.ArithShl:  "left shift",
+
+	abi.ArithMod:    "remainder",
+	abi.ArithShr:    "right shift",
+	abi.ArithAnd:    "bitwise and",
+	abi.ArithOr:     "bitwise or",
+	abi.ArithXor:    "bitwise xor",
+	abi.ArithAndNot: "bit clear",
+	abi.ArithNot:    "bitwise complement",
+}
+
+var arithOpSymbols = [...]string{
+	abi.ArithAdd: " + ",
+	abi.ArithSub: " - ",
+	abi.ArithMul: " * ",
+	abi.ArithDiv: " / ",
+	abi.ArithShl: " << ",
+}
+
+func (*ArithmeticError) RuntimeError() {}
+
+// Kind returns the class of the failure.
+func (e *ArithmeticError) Kind() ArithmeticKind {
+	return e.kind
+}
+
+// Is reports whether target is the sentinel error for e's kind,
+// such as [ErrOverflow].
+func (e *ArithmeticError) Is(target error) bool {
+	k, ok := target.(arithmeticKindError)
+	return ok && ArithmeticKind(k) == e.kind
+}
+
+// Op returns the name of the failed operation: "addition", "subtraction",
+// "multiplication", "division", "negation", "left shift" or "conversion".
+// The range violations of other operations name them "remainder",
+// "right shift", "bitwise and", "bitwise or", "bitwise xor", "bit clear"
+// or "bitwise complement".
+func (e *ArithmeticError) Op() string {
+	return arithOpNames[e.op]
+}
+
+// SourceType returns the name of the operands' type, such as "int8".
+// A defined type with //panikint: directives is named as in "pkg.T",
+// other types by their kind.
+func (e *ArithmeticError) SourceType() string {
+	if e.srcName != "" {
+		return e.srcName
+	}
+	return e.src.String()
+}
+
+// DestType returns the name of the result's type, like SourceType.
+// It differs from SourceType only for conversions.
+func (e *ArithmeticError) DestType() string {
+	if e.dstName != "" {
+		return e.dstName
+	}
+	return e.dst.String()
+}
+
+// Operands returns the operands of the failed operation as values of
+// the type named by SourceType, or of its underlying type if it is a
+// defined type. For conversions and negations, y is nil.
+// For left shifts, y is the shift count as a uint64.
+// For range violations of arithmetic, x is the result of the operation
+// instead, as a value of the underlying type of DestType, and y is nil.
+func (e *ArithmeticError) Operands() (x, y any) {
+	if e.kind == ArithmeticRangeViolation && e.op != abi.ArithConv {
+		return kindValue(e.x, e.dst), nil
+	}
+	x = kindValue(e.x, e.src)
+	switch e.op {
+	case abi.ArithConv, abi.ArithNeg:
+	case abi.ArithShl:
+		y = e.y
+	default:
+		y = kindValue(e.y, e.src)
+	}
+	return x, y
+}
+
+// Range returns the bounds of the range declared for the type named by
+// DestType with a //panikint:range directive, as values of its
+// underlying type, if e is a range violation. Otherwise, both are nil.
+func (e *ArithmeticError) Range() (lo, hi any) {
+	if e.kind != ArithmeticRangeViolation {
+		return nil, nil
+	}
+	return kindValue(e.lo, e.dst), kindValue(e.hi, e.dst)
+}
+
+// PC returns the address of the instruction following the call into
+// the runtime made by the failed check, like the pc results of [Caller].
+func (e *ArithmeticError) PC() uintptr {
+	return e.pc
+}
+
+// Portable32 reports whether the failed check is one of the checks of
+// the 32-bit portability mode, enabled with -gcflags=-int32detect or the
+// int32 class of the -panikint build flag. Such a check fails on a 64-bit
+// platform when an int or uint value does not fit in 32 bits, as it
+// would fail on a 32-bit platform. The value reported by Error is then
+// the one the operation would produce there.
+func (e *ArithmeticError) Portable32() bool {
+	return e.bits32
+}
+
+// Column returns the column of the failed operation on the source line
+// of PC, counting from 1, or 0 if it is unknown. It tells apart checks
+// on the same line, such as the two additions in a + b + c.
+func (e *ArithmeticError) Column() int {
+	return e.col
+}
+
+// kindFloat reports whether k is a floating-point kind.
+func kindFloat(k abi.Kind) bool {
+	return k == abi.Float32 || k == abi.Float64
+}
+
+// kindSigned reports whether k is a signed integer kind.
+func kindSigned(k abi.Kind) bool {
+	return k >= abi.Int && k <= abi.Int64
+}
+
+// kindWrap truncates v to the width of the integer kind k and extends it
+// back to 64 bits, the way a value of that kind is stored in ArithmeticError.
+func kindWrap(v uint64, k abi.Kind) uint64 {
+	var bits uint
+	switch k {
+	case abi.Int8, abi.Uint8:
+		bits = 8
+	case abi.Int16, abi.Uint16:
+		bits = 16
+	case abi.Int32, abi.Uint32:
+		bits = 32
+	case abi.Int, abi.Uint, abi.Uintptr:
+		bits = 8 * goarch.PtrSize
+	default:
+		bits = 64
+	}
+	shift := 64 - bits
+	if kindSigned(k) {
+		return uint64(int64(v<<shift) >> shift)
+	}
+	return v << shift >> shift
+}
+
+// kindValue returns v, as stored in ArithmeticError, as a value of kind k.
+func kindValue(v uint64, k abi.Kind) any {
+	switch k {
+	case abi.Int:
+		return int(v)
+	case abi.Int8:
+		return int8(v)
+	case abi.Int16:
+		return int16(v)
+	case abi.Int32:
+		return int32(v)
+	case abi.Int64:
+		return int64(v)
+	case abi.Uint:
+		return uint(v)
+	case abi.Uint8:
+		return uint8(v)
+	case abi.Uint16:
+		return uint16(v)
+	case abi.Uint32:
+		return uint32(v)
+	case abi.Uintptr:
+		return uintptr(v)
+	case abi.Float32:
+		return float32(float64frombits(v))
+	case abi.Float64:
+		return float64frombits(v)
+	}
+	return v
+}
+
+// result returns the value the failed operation actually produced.
+func (e *ArithmeticError) result() uint64 {
+	var r uint64
+	switch e.op {
+	case abi.ArithAdd:
+		r = e.x + e.y
+	case abi.ArithSub:
+		r = e.x - e.y
+	case abi.ArithMul:
+		r = e.x * e.y
+	case abi.ArithNeg:
+		r = -e.x
+	case abi.ArithShl:
+		r = e.x << e.y
+	case abi.ArithDiv:
+		switch {
+		case e.y == 0:
+		case kindSigned(e.src):
+			r = uint64(int64(e.x) / int64(e.y))
+		default:
+			r = e.x / e.y
+		}
+	default:
+		r = e.x
+	}
+	return kindWrap(r, e.resultKind())
+}
+
+// resultKind returns the kind the result of the failed operation is
+// wrapped to: its type, or the 32-bit version of int and uint for the
+// checks of the 32-bit portability mode.
+func (e *ArithmeticError) resultKind() abi.Kind {
+	if e.bits32 {
+		switch e.dst {
+		case abi.Int:
+			return abi.Int32
+		case abi.Uint:
+			return abi.Uint32
+		}
+	}
+	return e.dst
+}
+
+// appendDestType appends the name of the result's type to b.
+func (e *ArithmeticError) appendDestType(b []byte) []byte {
+	if e.bits32 {
+		b = append(b, "32-bit "...)
+	}
+	return append(b, e.DestType()...)
+}
+
+func (e *ArithmeticError) Error() string {
+	// max message length is 128: "runtime error: uintptr multiplication underflow: %x * %y (wrapped to %r)"
+	// x, y and the result can be at most 20 characters each.
+	b := make([]byte, 0, 128)
+	b = append(b, "runtime error: "...)
+	srcSigned := kindSigned(e.src)
+	if e.kind == ArithmeticRangeViolation {
+		// As in "pkg.T(150) out of range 0..100" for conversions, or
+		// "pkg.T addition result 110 out of range 0..100".
+		dstSigned := kindSigned(e.dst)
+		b = append(b, e.DestType()...)
+		if e.op == abi.ArithConv {
+			// The operand of the conversion, which may not fit in
+			// the underlying type of DestType.
+			b = append(b, '(')
+			if kindFloat(e.src) {
+				bitSize := 64
+				if e.src == abi.Float32 {
+					bitSize = 32
+				}
+				b = strconv.AppendFloat(b, float64frombits(e.x), 'g', -1, bitSize)
+			} else {
+				b = appendIntStr(b, int64(e.x), srcSigned)
+			}
+			b = append(b, ')')
+		} else {
+			b = append(b, ' ')
+			b = append(b, arithOpNames[e.op]...)
+			b = append(b, " result "...)
+			b = appendIntStr(b, int64(e.x), dstSigned)
+		}
+		b = append(b, " out of range "...)
+		b = appendIntStr(b, int64(e.lo), dstSigned)
+		b = append(b, ".."...)
+		b = appendIntStr(b, int64(e.hi), dstSigned)
+		return string(b)
+	}
+	if e.kind == ArithmeticOutOfRange {
+		// The result of the conversion depends on the architecture.
+		b = append(b, e.SourceType()...)
+		b = append(b, '(')
+		bitSize := 64
+		if e.src == abi.Float32 {
+			bitSize = 32
+		}
+		b = strconv.AppendFloat(b, float64frombits(e.x), 'g', -1, bitSize)
+		b = append(b, ") cannot fit in "...)
+		b = e.appendDestType(b)
+		return string(b)
+	}
+	if e.op == abi.ArithConv {
+		b = append(b, e.SourceType()...)
+		b = append(b, '(')
+		b = appendIntStr(b, int64(e.x), srcSigned)
+		if e.kind == ArithmeticSignChange {
+			b = append(b, ") changes sign in "...)
+			b = e.appendDestType(b)
+			b = append(b, " (converted to "...)
+		} else {
+			b = append(b, ") cannot fit in "...)
+			b = e.appendDestType(b)
+			b = append(b, " (truncated to "...)
+		}
+	} else {
+		b = e.appendDestType(b)
+		b = append(b, ' ')
+		b = append(b, arithOpNames[e.op]...)
+		b = append(b, ' ')
+		if e.kind == ArithmeticUnderflow {
+			b = append(b, "underflow: "...)
+		} else {
+			b = append(b, "overflow: "...)
+		}
+		if e.op == abi.ArithNeg {
+			b = append(b, "-("...)
+			b = appendIntStr(b, int64(e.x), srcSigned)
+			b = append(b, ')')
+		} else {
+			b = appendIntStr(b, int64(e.x), srcSigned)
+			b = append(b, arithOpSymbols[e.op]...)
+			b = appendIntStr(b, int64(e.y), srcSigned && e.op != abi.ArithShl)
+		}
+		b = append(b, " (wrapped to "...)
+	}
+	b = appendIntStr(b, int64(e.result()), kindSigned(e.dst))
+	b = append(b, ')')
+	return string(b)
+}
+
 type stringer interface {
 	String() string
 }
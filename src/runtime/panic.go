@@ -322,9 +322,13 @@ func panicoverflow() {
 	panic(overflowError)
 }
 
-func panicoverflowdetailed(msg string) {
-	panicCheck2(msg)
-	panic(errorString(msg))
+// panicoverflowdetailed reports that x op y does not fit in the operand
+// type. code is an abi.ArithEncode encoding of the operation, its type
+// and its column.
+func panicoverflowdetailed(x, y uint64, code int) {
+	panicCheck2("integer overflow")
+	op, src, dst, col := abi.ArithDecode(code)
+	panic(newArithmeticError(x, y, op, src, dst, sys.GetCallerPC(), col))
 }
 
 var truncateError = error(errorString("integer truncation"))
@@ -334,9 +338,67 @@ func panictruncate() {
 	panic(truncateError)
 }
 
-func panictruncatedetailed(msg string) {
-	panicCheck2(msg)
-	panic(errorString(msg))
+// panictruncatedetailed reports that the conversion of x does not fit in
+// the destination type. code is an abi.ArithEncode encoding of the
+// conversion, its source and destination types and its column.
+func panictruncatedetailed(x uint64, code int) {
+	panicCheck2("integer truncation")
+	op, src, dst, col := abi.ArithDecode(code)
+	panic(newArithmeticError(x, 0, op, src, dst, sys.GetCallerPC(), col))
+}
+
+// panicfloatconvdetailed reports that the float x, converted to an integer
+// type, is NaN or out of range. code is an abi.ArithEncode encoding of the
+// conversion, its source and destination types and its column. A float32
+// source is passed widened to float64.
+func panicfloatconvdetailed(x float64, code int) {
+	panicCheck2("float conversion out of range")
+	op, src, dst, col := abi.ArithDecode(code)
+	panic(newArithmeticError(float64bits(x), 0, op, src, dst, sys.GetCallerPC(), col))
+}
+
+// panicarithnamed is panicoverflowdetailed and panictruncatedetailed for
+// the checks whose source or destination type is a defined type with
+// //panikint: directives. y is 0 for conversions. src and dst are the
+// names of these types, or empty for the other types.
+func panicarithnamed(x, y uint64, code int, src, dst string) {
+	op, srcKind, dstKind, col := abi.ArithDecode(code)
+	if op&^abi.ArithInt32 == abi.ArithConv {
+		panicCheck2("integer truncation")
+	} else {
+		panicCheck2("integer overflow")
+	}
+	e := newArithmeticError(x, y, op, srcKind, dstKind, sys.GetCallerPC(), col)
+	e.srcName, e.dstName = src, dst
+	panic(e)
+}
+
+// panicfloatconvnamed is panicfloatconvdetailed for the conversions to a
+// defined type with //panikint: directives, whose name is dst.
+func panicfloatconvnamed(x float64, code int, dst string) {
+	panicCheck2("float conversion out of range")
+	op, src, dstKind, col := abi.ArithDecode(code)
+	e := newArithmeticError(float64bits(x), 0, op, src, dstKind, sys.GetCallerPC(), col)
+	e.dstName = dst
+	panic(e)
+}
+
+// panicrange reports that x, the result of the operation or the operand
+// of the conversion encoded by code, lies outside the range lo..hi
+// declared by a //panikint:range directive for the type named dst. x is
+// sign or zero extended to 64 bits according to its type, lo and hi
+// according to the type named dst.
+func panicrange(x, lo, hi uint64, code int, dst string) {
+	panicCheck2("integer range violation")
+	op, src, dstKind, col := abi.ArithDecode(code)
+	panic(newRangeError(x, lo, hi, op, src, dstKind, dst, sys.GetCallerPC(), col))
+}
+
+// panicrangefloat is panicrange for the conversions of the float x.
+func panicrangefloat(x float64, lo, hi uint64, code int, dst string) {
+	panicCheck2("integer range violation")
+	op, src, dstKind, col := abi.ArithDecode(code)
+	panic(newRangeError(float64bits(x), lo, hi, op, src, dstKind, dst, sys.GetCallerPC(), col))
 }
 
 var floatError = error(errorString("floating point error"))
@@ -0,0 +1,66 @@
+// Copyright 2025 The Go Authors. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package panikint reports which integer checks the compiler inserted
+// into the running program.
+//
+// The answer reflects the compiler flags this package was compiled with:
+// those set by the -panikint flag of the go command, or the compiler
+// defaults if it was not given, and the flags given to every package,
+// such as -gcflags=all=-overflowdetect=false. Flags given to some
+// packages only, such as -gcflags=-truncationdetect, are not taken into
+// account. Builds with the -panikint flag also satisfy the panikint
+// build constraint and one panikint_<class> constraint per enabled
+// class, such as panikint_truncation.
+package panikint
+
+import "internal/strconv"
+
+// A Check is a class of checks inserted by the compiler.
+type Check int
+
+const (
+	Overflow   Check = iota // addition, subtraction, multiplication and division
+	Negation                // negation
+	Truncation              // integer conversions
+	Shift                   // left shifts
+	FloatConv               // float to integer conversions
+	Int32                   // int and uint values outside the 32-bit range, on 64-bit targets
+	Range                   // values outside the range declared for their type, always enabled
+)
+
+var checkNames = [...]string{
+	Overflow:   "overflow",
+	Negation:   "negation",
+	Truncation: "truncation",
+	Shift:      "shift",
+	FloatConv:  "floatconv",
+	Int32:      "int32",
+	Range:      "range",
+}
+
+// String returns the name of c as accepted by the -panikint flag,
+// or as reported by go tool panikint for Range.
+func (c Check) String() string {
+	if c < 0 || int(c) >= len(checkNames) {
+		return "Check(" + strconv.Itoa(int(c)) + ")"
+	}
+	return checkNames[c]
+}
+
+// enabledChecks returns the set of check classes enabled in the
+// compiler, as bits indexed by Check. The compiler replaces its calls
+// with that set.
+//
+//go:noinline
+func enabledChecks() uint { return 0 }
+
+// checks is the set of check classes enabled when this package was
+// compiled.
+var checks = enabledChecks()
+
+// Enabled reports whether checks of class c are enabled in the program.
+func Enabled(c Check) bool {
+	return c >= 0 && int(c) < len(checkNames) && checks&(1<<c) != 0
+}
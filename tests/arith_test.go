@@ -0,0 +1,207 @@
+package tests
+
+import (
+	"math"
+	"math/arith"
+	"strings"
+	"testing"
+)
+
+// arithCheckSource compares the results of math/arith with those of
+// math/big, for all the pairs of 8-bit values and for values around
+// the limits and the square root of the limits of the other types.
+const arithCheckSource = `package main
+
+import (
+	"fmt"
+	"math/arith"
+	"math/big"
+	"os"
+	"unsafe"
+)
+
+var failed bool
+
+type op[T arith.Integer] struct {
+	name       string
+	exact      func(z, x, y *big.Int) *big.Int
+	wrapping   func(x, y T) T
+	checked    func(x, y T) (T, bool)
+	saturating func(x, y T) T
+}
+
+func ops[T arith.Integer]() []op[T] {
+	return []op[T]{
+		{"Add", (*big.Int).Add, arith.WrappingAdd[T], arith.CheckedAdd[T], arith.SaturatingAdd[T]},
+		{"Sub", (*big.Int).Sub, arith.WrappingSub[T], arith.CheckedSub[T], arith.SaturatingSub[T]},
+		{"Mul", (*big.Int).Mul, arith.WrappingMul[T], arith.CheckedMul[T], arith.SaturatingMul[T]},
+	}
+}
+
+func toBig[T arith.Integer](x T) *big.Int {
+	if ^T(0) < 0 {
+		return big.NewInt(int64(x))
+	}
+	return new(big.Int).SetUint64(uint64(x))
+}
+
+func fromBig[T arith.Integer](x *big.Int) T {
+	if ^T(0) < 0 {
+		return T(x.Int64())
+	}
+	return T(x.Uint64())
+}
+
+func check[T arith.Integer](name string, vals []T) {
+	bits := uint(8 * unsafe.Sizeof(T(0)))
+	mod := new(big.Int).Lsh(big.NewInt(1), bits)
+	lo, hi := big.NewInt(0), new(big.Int).Sub(mod, big.NewInt(1))
+	if ^T(0) < 0 {
+		lo.Neg(new(big.Int).Rsh(mod, 1))
+		hi.Rsh(mod, 1).Sub(hi, big.NewInt(1))
+	}
+	for _, o := range ops[T]() {
+		for _, x := range vals {
+			for _, y := range vals {
+				exact := o.exact(new(big.Int), toBig(x), toBig(y))
+				fits := exact.Cmp(lo) >= 0 && exact.Cmp(hi) <= 0
+				wrapped := new(big.Int).Mod(exact, mod)
+				if wrapped.Cmp(hi) > 0 {
+					wrapped.Sub(wrapped, mod)
+				}
+				saturated := exact
+				if exact.Cmp(lo) < 0 {
+					saturated = lo
+				} else if exact.Cmp(hi) > 0 {
+					saturated = hi
+				}
+				want := fromBig[T](wrapped)
+				if got := o.wrapping(x, y); got != want {
+					fmt.Printf("Wrapping%s[%s](%d, %d) = %d, want %d\n", o.name, name, x, y, got, want)
+					failed = true
+				}
+				if got, ok := o.checked(x, y); got != want || ok != fits {
+					fmt.Printf("Checked%s[%s](%d, %d) = %d, %v, want %d, %v\n", o.name, name, x, y, got, ok, want, fits)
+					failed = true
+				}
+				want = fromBig[T](saturated)
+				if got := o.saturating(x, y); got != want {
+					fmt.Printf("Saturating%s[%s](%d, %d) = %d, want %d\n", o.name, name, x, y, got, want)
+					failed = true
+				}
+			}
+		}
+	}
+}
+
+func all[T arith.Integer]() []T {
+	var vals []T
+	start := 0
+	if ^T(0) < 0 {
+		start = -128
+	}
+	for i := start; i < start+256; i++ {
+		vals = append(vals, T(i))
+	}
+	return vals
+}
+
+func edges[T arith.Integer]() []T {
+	bits := 8 * unsafe.Sizeof(T(0))
+	one := T(1)
+	min, max := T(0), ^T(0)
+	if max < 0 {
+		max = T(^uint64(0) >> (65 - bits))
+		min = ^max
+	}
+	root := one << (bits / 2)
+	vals := []T{0, one, one + one, min, min + one, max, max - one, root, root - one, root + one, max / 3}
+	if min < 0 {
+		vals = append(vals, -one, -one-one, -root, -root-one, -root+one, min/3)
+	}
+	return vals
+}
+
+type named int16
+
+func main() {
+	check("int8", all[int8]())
+	check("uint8", all[uint8]())
+	check("int16", edges[int16]())
+	check("uint16", edges[uint16]())
+	check("named", edges[named]())
+	check("int32", edges[int32]())
+	check("uint32", edges[uint32]())
+	check("int64", edges[int64]())
+	check("uint64", edges[uint64]())
+	check("int", edges[int]())
+	check("uint", edges[uint]())
+	check("uintptr", edges[uintptr]())
+	if failed {
+		os.Exit(1)
+	}
+	fmt.Println("ok")
+}
+`
+
+func TestArithLibrary(t *testing.T) {
+	for _, gcflags := range []string{"", "-d=ssa/intrinsics/off=1"} {
+		// The build may report warnings on standard error.
+		var stderr strings.Builder
+		cmd := probeCommand(t, arithCheckSource, "run", "-panikint=all", "-gcflags="+gcflags, ".")
+		cmd.Stderr = &stderr
+		out, err := cmd.Output()
+		if err != nil || string(out) != "ok\n" {
+			t.Errorf("with -gcflags=%q: %v\n%s%s", gcflags, err, stderr.String(), out)
+		}
+	}
+}
+
+func TestArithWrappingUnchecked(t *testing.T) {
+	var x int8 = math.MaxInt8
+	var u uint64 = math.MaxUint64
+	if got := arith.WrappingAdd(x, 1); got != math.MinInt8 {
+		t.Errorf("WrappingAdd(%d, 1) = %d, want %d", x, got, math.MinInt8)
+	}
+	if got := arith.WrappingMul(u, u); got != 1 {
+		t.Errorf("WrappingMul(%d, %d) = %d, want 1", u, u, got)
+	}
+	if got, ok := arith.CheckedSub(uint8(0), 1); got != math.MaxUint8 || ok {
+		t.Errorf("CheckedSub(0, 1) = %d, %v, want %d, false", got, ok, math.MaxUint8)
+	}
+	if got := arith.SaturatingSub(int64(math.MinInt64), 1); got != math.MinInt64 {
+		t.Errorf("SaturatingSub(%d, 1) = %d, want %d", int64(math.MinInt64), got, int64(math.MinInt64))
+	}
+}
+
+func TestArithIntrinsics(t *testing.T) {
+	skipIfNoCheckedOps(t)
+	const src = `package main
+
+import "math/arith"
+
+func checked(x, y int32) (int32, bool) { return arith.CheckedMul(x, y) }
+
+func saturating(x, y uint64) uint64 { return arith.SaturatingAdd(x, y) }
+
+func wrapping(x, y int8) int8 { return arith.WrappingSub(x, y) }
+
+func main() {
+	println(checked(1, 2))
+	println(saturating(3, 4), wrapping(5, 6))
+}
+`
+	out, err := buildOutput(t, src, "-gcflags=-d=ssa/intrinsics/debug=1")
+	if err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	for _, want := range []string{
+		"main.go:5:65: intrinsic substitution for CheckedMul[go.shape.int32]",
+		"main.go:7:65: intrinsic substitution for SaturatingAdd[go.shape.uint64]",
+		"main.go:9:57: intrinsic substitution for WrappingSub[go.shape.int8]",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in output:\n%s", want, out)
+		}
+	}
+}
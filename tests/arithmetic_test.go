@@ -1,6 +1,10 @@
 package tests
 
 import (
+	"errors"
+	"math"
+	"runtime"
+	"strings"
 	"sync/atomic"
 	"testing"
 )
@@ -12,7 +16,7 @@ func TestSignedInt8Overflow(t *testing.T) {
 		}
 	}()
 	var a int8 = 127
-	var b int8 = 1
+	b := opaque(int8(1))
 	_ = a + b
 }
 
@@ -23,7 +27,7 @@ func TestSignedInt8Underflow(t *testing.T) {
 		}
 	}()
 	var a int8 = -128
-	var b int8 = 1
+	b := opaque(int8(1))
 	_ = a - b
 }
 
@@ -34,7 +38,7 @@ func TestSignedInt16Overflow(t *testing.T) {
 		}
 	}()
 	var a int16 = 32767
-	var b int16 = 1
+	b := opaque(int16(1))
 	_ = a + b
 }
 
@@ -45,7 +49,7 @@ func TestSignedInt16Underflow(t *testing.T) {
 		}
 	}()
 	var a int16 = -32768
-	var b int16 = 1
+	b := opaque(int16(1))
 	_ = a - b
 }
 
@@ -56,7 +60,7 @@ func TestSignedInt32Overflow(t *testing.T) {
 		}
 	}()
 	var a int32 = 2147483647
-	var b int32 = 1
+	b := opaque(int32(1))
 	_ = a + b
 }
 
@@ -67,7 +71,7 @@ func TestSignedInt32Underflow(t *testing.T) {
 		}
 	}()
 	var a int32 = -2147483648
-	var b int32 = 1
+	b := opaque(int32(1))
 	_ = a - b
 }
 
@@ -78,7 +82,7 @@ func TestUnsignedUint8Overflow(t *testing.T) {
 		}
 	}()
 	var a uint8 = 255
-	var b uint8 = 1
+	b := opaque(uint8(1))
 	_ = a + b
 }
 
@@ -89,7 +93,7 @@ func TestUnsignedUint8Underflow(t *testing.T) {
 		}
 	}()
 	var a uint8 = 0
-	var b uint8 = 1
+	b := opaque(uint8(1))
 	_ = a - b
 }
 
@@ -100,7 +104,7 @@ func TestUnsignedUint16Overflow(t *testing.T) {
 		}
 	}()
 	var a uint16 = 65535
-	var b uint16 = 1
+	b := opaque(uint16(1))
 	_ = a + b
 }
 
@@ -111,7 +115,7 @@ func TestUnsignedUint16Underflow(t *testing.T) {
 		}
 	}()
 	var a uint16 = 0
-	var b uint16 = 1
+	b := opaque(uint16(1))
 	_ = a - b
 }
 
@@ -122,7 +126,7 @@ func TestUnsignedUint32Overflow(t *testing.T) {
 		}
 	}()
 	var a uint32 = 4294967295
-	var b uint32 = 1
+	b := opaque(uint32(1))
 	_ = a + b
 }
 
@@ -133,7 +137,7 @@ func TestUnsignedUint32Underflow(t *testing.T) {
 		}
 	}()
 	var a uint32 = 0
-	var b uint32 = 1
+	b := opaque(uint32(1))
 	_ = a - b
 }
 
@@ -144,7 +148,7 @@ func TestUnsignedUint64Overflow(t *testing.T) {
 		}
 	}()
 	var a uint64 = 18446744073709551615
-	var b uint64 = 1
+	b := opaque(uint64(1))
 	_ = a + b
 }
 
@@ -155,7 +159,7 @@ func TestUnsignedUint64Underflow(t *testing.T) {
 		}
 	}()
 	var a uint64 = 0
-	var b uint64 = 1
+	b := opaque(uint64(1))
 	_ = a - b
 }
 
@@ -166,7 +170,7 @@ func TestSignedDivisionOverflow(t *testing.T) {
 		}
 	}()
 	var a int8 = -128
-	var b int8 = -1
+	b := opaque(int8(-1))
 	_ = a / b
 }
 
@@ -178,7 +182,7 @@ func TestBoundaryArithmeticInt8(t *testing.T) {
 		}
 	}()
 	var a int8 = 126
-	var b int8 = 2
+	b := opaque(int8(2))
 	_ = a + b
 }
 
@@ -189,7 +193,7 @@ func TestBoundaryArithmeticInt16(t *testing.T) {
 		}
 	}()
 	var a int16 = 32766
-	var b int16 = 2
+	b := opaque(int16(2))
 	_ = a + b
 }
 
@@ -200,7 +204,7 @@ func TestBoundaryArithmeticInt32(t *testing.T) {
 		}
 	}()
 	var a int32 = 2147483646
-	var b int32 = 2
+	b := opaque(int32(2))
 	_ = a + b
 }
 
@@ -211,7 +215,7 @@ func TestBoundaryArithmeticUint8(t *testing.T) {
 		}
 	}()
 	var a uint8 = 254
-	var b uint8 = 2
+	b := opaque(uint8(2))
 	_ = a + b
 }
 
@@ -222,7 +226,7 @@ func TestBoundaryArithmeticUint16(t *testing.T) {
 		}
 	}()
 	var a uint16 = 65534
-	var b uint16 = 2
+	b := opaque(uint16(2))
 	_ = a + b
 }
 
@@ -233,7 +237,7 @@ func TestBoundaryArithmeticUint32(t *testing.T) {
 		}
 	}()
 	var a uint32 = 4294967294
-	var b uint32 = 2
+	b := opaque(uint32(2))
 	_ = a + b
 }
 
@@ -244,7 +248,7 @@ func TestBoundaryArithmeticUint64(t *testing.T) {
 		}
 	}()
 	var a uint64 = 18446744073709551614
-	var b uint64 = 2
+	b := opaque(uint64(2))
 	_ = a + b
 }
 
@@ -255,7 +259,7 @@ func TestNearNegativeBoundaryInt8(t *testing.T) {
 		}
 	}()
 	var a int8 = -127
-	var b int8 = 2
+	b := opaque(int8(2))
 	_ = a - b
 }
 
@@ -266,7 +270,7 @@ func TestNearNegativeBoundaryInt16(t *testing.T) {
 		}
 	}()
 	var a int16 = -32767
-	var b int16 = 2
+	b := opaque(int16(2))
 	_ = a - b
 }
 
@@ -277,7 +281,7 @@ func TestNearNegativeBoundaryInt32(t *testing.T) {
 		}
 	}()
 	var a int32 = -2147483647
-	var b int32 = 2
+	b := opaque(int32(2))
 	_ = a - b
 }
 
@@ -319,7 +323,7 @@ func TestSubtractFromZeroUnsigned(t *testing.T) {
 		}
 	}()
 	var a uint8 = 0
-	var b uint8 = 255
+	b := opaque(uint8(255))
 	_ = a - b
 }
 
@@ -331,7 +335,7 @@ func TestInt16DivisionOverflow(t *testing.T) {
 		}
 	}()
 	var a int16 = -32768
-	var b int16 = -1
+	b := opaque(int16(-1))
 	_ = a / b
 }
 
@@ -342,10 +346,286 @@ func TestInt32DivisionOverflow(t *testing.T) {
 		}
 	}()
 	var a int32 = -2147483648
-	var b int32 = -1
+	b := opaque(int32(-1))
 	_ = a / b
 }
 
+func TestInt64DivisionOverflow(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Expected panic for int64 division overflow (MIN_INT / -1)")
+		}
+	}()
+	var a int64 = -9223372036854775808
+	b := opaque(int64(-1))
+	_ = a / b
+}
+
+// 64-bit signed and platform-sized integer tests
+func TestSignedInt64Overflow(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Expected panic for int64 overflow")
+		}
+	}()
+	var a int64 = 9223372036854775807
+	b := opaque(int64(1))
+	_ = a + b
+}
+
+func TestSignedInt64Underflow(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Expected panic for int64 underflow")
+		}
+	}()
+	var a int64 = -9223372036854775808
+	b := opaque(int64(1))
+	_ = a - b
+}
+
+func TestSignedInt64MultiplicationOverflow(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Expected panic for int64 multiplication overflow")
+		}
+	}()
+	var a int64 = 1 << 32
+	b := opaque(int64(1 << 31))
+	_ = a * b
+}
+
+func TestSignedIntOverflow(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Expected panic for int overflow")
+		}
+	}()
+	a := opaque(math.MaxInt)
+	b := 1
+	_ = a + b
+}
+
+func TestMinIntTimesNegativeOne(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Expected panic for MIN_INT * -1")
+		}
+	}()
+	var a int8 = -1
+	b := opaque(int8(-128))
+	_ = a * b
+}
+
+func TestUintptrOverflow(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Expected panic for uintptr overflow")
+		}
+	}()
+	var a uintptr = ^uintptr(0)
+	b := opaque(uintptr(1))
+	_ = a + b
+}
+
+// expectPanicMessage runs f and checks that it panics with an error
+// whose message is want.
+func expectPanicMessage(t *testing.T, want string, f func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		r := recover()
+		if r == nil {
+			t.Fatalf("Expected panic %q", want)
+		}
+		err, ok := r.(error)
+		if !ok {
+			t.Fatalf("Expected error panic value, got %T", r)
+		}
+		if got := err.Error(); got != want {
+			t.Fatalf("Expected panic %q, got %q", want, got)
+		}
+	}()
+	f()
+}
+
+func TestOverflowMessageOperands(t *testing.T) {
+	var a8, b8 int8 = 127, 1
+	expectPanicMessage(t, "runtime error: int8 addition overflow: 127 + 1 (wrapped to -128)", func() {
+		_ = a8 + b8
+	})
+	var u8 uint8 = 3
+	expectPanicMessage(t, "runtime error: uint8 subtraction underflow: 3 - 4 (wrapped to 255)", func() {
+		_ = u8 - 4
+	})
+	var a16 int16 = -300
+	expectPanicMessage(t, "runtime error: int16 multiplication underflow: -300 * 200 (wrapped to 5536)", func() {
+		_ = a16 * 200
+	})
+	var a32, b32 int32 = math.MinInt32, -1
+	expectPanicMessage(t, "runtime error: int32 division overflow: -2147483648 / -1 (wrapped to -2147483648)", func() {
+		_ = a32 / b32
+	})
+	var u64 uint64 = math.MaxUint64
+	expectPanicMessage(t, "runtime error: uint64 addition overflow: 18446744073709551615 + 2 (wrapped to 1)", func() {
+		_ = u64 + 2
+	})
+	var i64 int64 = math.MinInt64
+	expectPanicMessage(t, "runtime error: int64 subtraction underflow: -9223372036854775808 - 1 (wrapped to 9223372036854775807)", func() {
+		_ = i64 - 1
+	})
+	expectPanicMessage(t, "runtime error: int32 negation overflow: -(-2147483648) (wrapped to -2147483648)", func() {
+		_ = -a32
+	})
+	expectPanicMessage(t, "runtime error: uint8 negation underflow: -(3) (wrapped to 253)", func() {
+		_ = -u8
+	})
+}
+
+var _ runtime.Error = (*runtime.ArithmeticError)(nil)
+
+// recoverArithmeticError runs f and returns the *runtime.ArithmeticError
+// it panics with.
+func recoverArithmeticError(t *testing.T, f func()) (err *runtime.ArithmeticError) {
+	t.Helper()
+	defer func() {
+		r := recover()
+		var ok bool
+		if err, ok = r.(*runtime.ArithmeticError); !ok {
+			t.Fatalf("Expected *runtime.ArithmeticError panic, got %T: %v", r, r)
+		}
+	}()
+	f()
+	return nil
+}
+
+// opaque returns x. The optimizer does not see through it, so that the
+// checks the tests expect to fail are not reported at compile time as
+// always failing.
+//
+//go:noinline
+func opaque[T any](x T) T { return x }
+
+func TestArithmeticErrorKinds(t *testing.T) {
+	var a8 int8 = 100
+	var u8 uint8 = 1
+	var i32 int32 = math.MinInt32
+	var neg int32 = -1
+	tests := []struct {
+		name     string
+		f        func()
+		kind     runtime.ArithmeticKind
+		sentinel error
+	}{
+		{"add", func() { _ = a8 + a8 }, runtime.ArithmeticOverflow, runtime.ErrOverflow},
+		{"add negative", func() { _ = -a8 - a8 }, runtime.ArithmeticUnderflow, runtime.ErrUnderflow},
+		{"unsigned sub", func() { _ = u8 - 2 }, runtime.ArithmeticUnderflow, runtime.ErrUnderflow},
+		{"mul mixed signs", func() { _ = a8 * -2 }, runtime.ArithmeticUnderflow, runtime.ErrUnderflow},
+		{"mul negatives", func() { _ = i32 * neg }, runtime.ArithmeticOverflow, runtime.ErrOverflow},
+		{"div", func() { _ = i32 / neg }, runtime.ArithmeticDivisionOverflow, runtime.ErrDivisionOverflow},
+		{"neg", func() { _ = -i32 }, runtime.ArithmeticOverflow, runtime.ErrOverflow},
+		{"unsigned neg", func() { _ = -u8 }, runtime.ArithmeticUnderflow, runtime.ErrUnderflow},
+	}
+	for _, tt := range tests {
+		err := recoverArithmeticError(t, tt.f)
+		if err.Kind() != tt.kind {
+			t.Errorf("%s: Kind() = %v, want %v", tt.name, err.Kind(), tt.kind)
+		}
+		if !errors.Is(err, tt.sentinel) {
+			t.Errorf("%s: errors.Is(%v, %v) = false", tt.name, err, tt.sentinel)
+		}
+		if errors.Is(err, runtime.ErrTruncation) {
+			t.Errorf("%s: errors.Is(%v, ErrTruncation) = true", tt.name, err)
+		}
+	}
+}
+
+func TestArithmeticErrorDetails(t *testing.T) {
+	var a, b int16 = 30000, 5000
+	err := recoverArithmeticError(t, func() { _ = a + b })
+	if got := err.Op(); got != "addition" {
+		t.Errorf("Op() = %q, want %q", got, "addition")
+	}
+	if got := err.SourceType(); got != "int16" {
+		t.Errorf("SourceType() = %q, want %q", got, "int16")
+	}
+	if got := err.DestType(); got != "int16" {
+		t.Errorf("DestType() = %q, want %q", got, "int16")
+	}
+	if x, y := err.Operands(); x != int16(30000) || y != int16(5000) {
+		t.Errorf("Operands() = %v (%T), %v (%T), want 30000, 5000 int16", x, x, y, y)
+	}
+	fn := runtime.FuncForPC(err.PC())
+	if fn == nil || !strings.Contains(fn.Name(), "TestArithmeticErrorDetails") {
+		t.Errorf("PC() = %#x is not in TestArithmeticErrorDetails", err.PC())
+	}
+}
+
+func TestArithmeticErrorNegationOperands(t *testing.T) {
+	var a int8 = math.MinInt8
+	err := recoverArithmeticError(t, func() { _ = -a })
+	if got := err.Op(); got != "negation" {
+		t.Errorf("Op() = %q, want %q", got, "negation")
+	}
+	if x, y := err.Operands(); x != a || y != nil {
+		t.Errorf("Operands() = %v, %v, want -128, nil", x, y)
+	}
+}
+
+func TestSafeNegation(t *testing.T) {
+	// These operations should not panic
+	var a int8 = -127
+	if r := -a; r != 127 {
+		t.Fatalf("Expected 127, got %d", r)
+	}
+	var b int64 = math.MaxInt64
+	if r := -b; r != -math.MaxInt64 {
+		t.Fatalf("Expected %d, got %d", -math.MaxInt64, r)
+	}
+	var u uint32
+	if r := -u; r != 0 {
+		t.Fatalf("Expected 0, got %d", r)
+	}
+}
+
+func TestArithmeticErrorSameLine(t *testing.T) {
+	var a, b, c uint8 = 10, 250, 3
+	// Only the second multiplication overflows. The column is that of its operator.
+	err := recoverArithmeticError(t, func() { _ = a*c + b*c })
+	if x, y := err.Operands(); err.Op() != "multiplication" || x != b || y != c {
+		t.Errorf("got %s of %v and %v, want multiplication of %d and %d", err.Op(), x, y, b, c)
+	}
+	if got, want := err.Column(), 55; got != want {
+		t.Errorf("Column() = %d, want %d", got, want)
+	}
+
+	// The addition overflows after both multiplications succeed.
+	err = recoverArithmeticError(t, func() { _ = a*c + b })
+	if x, y := err.Operands(); err.Op() != "addition" || x != a*c || y != b {
+		t.Errorf("got %s of %v and %v, want addition of %d and %d", err.Op(), x, y, a*c, b)
+	}
+	if got, want := err.Column(), 51; got != want {
+		t.Errorf("Column() = %d, want %d", got, want)
+	}
+}
+
+func TestSafeInt64Arithmetic(t *testing.T) {
+	// These operations should not panic
+	var a int64 = -9223372036854775807
+	var b int64 = -1
+	if r := a + b; r != math.MinInt64 {
+		t.Fatalf("Expected %d, got %d", int64(math.MinInt64), r)
+	}
+	if r := a / b; r != math.MaxInt64 {
+		t.Fatalf("Expected %d, got %d", int64(math.MaxInt64), r)
+	}
+	var c int64 = -4294967296
+	var d int64 = 2147483648
+	if r := c * d; r != math.MinInt64 {
+		t.Fatalf("Expected %d, got %d", int64(math.MinInt64), r)
+	}
+}
+
 // Safe multiplication test
 func TestSafeMultiplication(t *testing.T) {
 	// These operations should not panic
@@ -376,18 +656,18 @@ func TestSafeArithmetic(t *testing.T) {
 
 // Suppression directive tests for overflow/underflow
 func TestOverflowSuppression_LineAbove(t *testing.T) {
-	// Expect no panic due to suppression marker on previous line
+	// Expect no panic due to suppression directive on previous line
 	var a int8 = 120
 	var b int8 = 10
-	// overflow_false_positive
+	//panikint:ignore overflow
 	_ = a + b
 }
 
 func TestOverflowSuppression_SameLine(t *testing.T) {
-	// Expect no panic due to suppression marker on same line
+	// Expect no panic due to suppression directive on same line
 	var a int8 = 120
 	var b int8 = 10
-	_ = a + b // overflow_false_positive
+	_ = a + b //panikint:ignore overflow
 }
 
 var g int32
@@ -405,3 +685,68 @@ func TestSliceCopyBug(t *testing.T) {
 	n := int(atomic.LoadInt32(&g))
 	doCopyAppend(n)
 }
+
+//go:noinline
+func jumpTable(x int) int {
+	switch x {
+	case 1, 2, 3:
+		return 1
+	case 4, 5, 6:
+		return 2
+	case 7, 8, 9:
+		return 3
+	}
+	return 0
+}
+
+// The compiler's own arithmetic, such as the index of a switch jump
+// table or the new length of an append, is not checked.
+func TestCompilerGeneratedArithmetic(t *testing.T) {
+	if got := jumpTable(math.MinInt64); got != 0 {
+		t.Errorf("jumpTable(MinInt64) = %d, want 0", got)
+	}
+	if got := jumpTable(5); got != 2 {
+		t.Errorf("jumpTable(5) = %d, want 2", got)
+	}
+	s := make([]struct{}, math.MaxInt)
+	for _, f := range []func(){
+		func() { _ = append(s, s...) },
+		func() { _ = append(s, make([]struct{}, 5)...) },
+	} {
+		func() {
+			const want = "runtime error: growslice: len out of range"
+			defer func() {
+				r := recover()
+				if err, ok := r.(error); !ok || err.Error() != want {
+					t.Errorf("append panicked with %v, want %q", r, want)
+				}
+			}()
+			f()
+		}()
+	}
+}
+
+func TestOverflowDetectionFlags(t *testing.T) {
+	const src = `package main
+
+func main() {
+	var a int8 = 127
+	var b int8 = -128
+	println(a+1, -b)
+}
+`
+	out, err := probeCommand(t, src, "run", "-gcflags=-overflowdetect=false -negationdetect=false", ".").CombinedOutput()
+	if err != nil || string(out) != "-128 -128\n" {
+		t.Errorf("with checks disabled: got %v, %q, want success and %q", err, out, "-128 -128\n")
+	}
+	out, err = probeCommand(t, src, "run", "-gcflags=-overflowdetect=false", ".").CombinedOutput()
+	const want = "panic: runtime error: int8 negation overflow"
+	if err == nil || !strings.Contains(string(out), want) {
+		t.Errorf("with -overflowdetect=false: got %v, want a panic with %q in output:\n%s", err, want, out)
+	}
+	out, err = probeCommand(t, src, "run", ".").CombinedOutput()
+	const wantAdd = "panic: runtime error: int8 addition overflow"
+	if err == nil || !strings.Contains(string(out), wantAdd) {
+		t.Errorf("by default: got %v, want a panic with %q in output:\n%s", err, wantAdd, out)
+	}
+}
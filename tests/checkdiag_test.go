@@ -0,0 +1,193 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const checkDiagSource = `package main
+
+func add(a, b int8) int8 { return a + b }
+
+func mask(x int) int { return x&0x7f + 1 }
+
+func conv(x int64) int32 { return int32(x) }
+
+func ignored(x uint8) uint8 {
+	//panikint:ignore overflow
+	return x * 3
+}
+
+func neg(x int) int { return -x }
+
+func f2i(f float64) int { return int(f) }
+
+func main() {
+	println(add(1, 2), mask(3), conv(4), ignored(5), neg(6), f2i(7))
+}
+`
+
+func TestCheckDiagnostics(t *testing.T) {
+	skipIfNoCheckedOps(t)
+	out, err := buildOutput(t, checkDiagSource, "-gcflags=-l -truncationdetect -floatconvdetect -d=ssa/check_overflow/debug=1")
+	if err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	for _, want := range []string{
+		"main.go:3:37: Found overflow check on int8 addition",
+		"main.go:5:38: Removed overflow check on int addition",
+		"main.go:7:41: Found truncation check on int64 to int32 conversion",
+		"main.go:11:11: Suppressed overflow check on uint8 multiplication",
+		"main.go:14:30: Found negation check on int negation",
+		"main.go:16:38: Found floatconv check on float64 to int conversion",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in output:\n%s", want, out)
+		}
+	}
+
+	// The checks are reported without optimizations too.
+	out, err = buildOutput(t, checkDiagSource, "-gcflags=-N -l -d=ssa/check_overflow/debug=1")
+	if err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	if want := "main.go:3:37: Found overflow check on int8 addition"; !strings.Contains(out, want) {
+		t.Errorf("with -N: missing %q in output:\n%s", want, out)
+	}
+
+	out, err = buildOutput(t, checkDiagSource, "-gcflags=-l -panikint.exclude=main -d=ssa/check_overflow/debug=1")
+	if err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	for _, want := range []string{
+		"main.go:3:37: Excluded overflow check on int8 addition",
+		"main.go:14:30: Excluded negation check on int negation",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("with -panikint.exclude=main: missing %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Found") || strings.Contains(out, "truncation") {
+		t.Errorf("with -panikint.exclude=main: unexpected checks in output:\n%s", out)
+	}
+}
+
+func TestCheckDiagnosticsJSON(t *testing.T) {
+	skipIfNoCheckedOps(t)
+	dir := t.TempDir()
+	out, err := buildOutput(t, checkDiagSource, "-gcflags=-l -json=0,"+dir)
+	if err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	log, err := os.ReadFile(filepath.Join(dir, "main", "main.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`{"range":{"start":{"line":3,"character":37},"end":{"line":3,"character":37}},"severity":3,"code":"arithCheck","source":"go compiler","message":"overflow check on int8 addition"}`,
+		`{"range":{"start":{"line":5,"character":38},"end":{"line":5,"character":38}},"severity":3,"code":"arithCheckRemoved","source":"go compiler","message":"overflow check on int addition"}`,
+		`{"range":{"start":{"line":11,"character":11},"end":{"line":11,"character":11}},"severity":3,"code":"arithCheckSuppressed","source":"go compiler","message":"overflow check on uint8 multiplication"}`,
+	} {
+		if !strings.Contains(string(log), want) {
+			t.Errorf("missing %s in log:\n%s", want, log)
+		}
+	}
+}
+
+const failingCheckSource = `package main
+
+func add(a, b int8) int8 { return a + b }
+
+func double() int8 {
+	var x int8 = 100
+	return x + x
+}
+
+func narrow() uint8 {
+	var x int64 = 300
+	return uint8(x)
+}
+
+func main() {
+	println(add(1, 2), add(100, 100), double(), narrow())
+}
+`
+
+func TestFailingCheckDiagnostics(t *testing.T) {
+	out, err := buildOutput(t, failingCheckSource, "-panikint=all")
+	if err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	for _, want := range []string{
+		"main.go:7:11: overflow check always fails: int8 addition overflow: 100 + 100 (wrapped to -56)\n",
+		"main.go:12:15: truncation check always fails: int64(300) cannot fit in uint8 (truncated to 44)\n",
+		// add only fails where it is inlined with constant operands.
+		"main.go:16:24: overflow check always fails: int8 addition overflow: 100 + 100 (wrapped to -56)\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "main.go:3:") || strings.Count(out, "always fails") != 3 {
+		t.Errorf("unexpected diagnostics in output:\n%s", out)
+	}
+
+	out, err = buildOutput(t, failingCheckSource, "-panikint=all", "-gcflags=-panikint.strict")
+	want := "main.go:7:11: overflow check always fails: int8 addition overflow: 100 + 100 (wrapped to -56)\n"
+	if err == nil || !strings.Contains(out, want) {
+		t.Errorf("with -panikint.strict: got %v, want an error with %q in output:\n%s", err, want, out)
+	}
+}
+
+const fusedCheckSource = `package main
+
+func chain() int8 {
+	var y int8 = 100
+	a := y - 50
+	return a * 3
+}
+
+func label(x int8) int8 {
+	goto L
+L:
+	x = 100
+	return x + x
+}
+
+func zero(c bool, a uint) uint {
+	if c {
+		a = 3
+	}
+	if c {
+		return -(a - a)
+	}
+	return a
+}
+
+func main() {
+	println(chain(), label(1), zero(true, 1))
+}
+`
+
+// The blocks of the checks are fused with those before them, once the
+// checks there are folded away, and the operands of the check in zero
+// are constants without making it fail.
+func TestFailingCheckFusedBlocks(t *testing.T) {
+	out, err := buildOutput(t, fusedCheckSource, "-panikint=all,int32", "-gcflags=-l")
+	if err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	for _, want := range []string{
+		"main.go:6:11: overflow check always fails: int8 multiplication overflow: 50 * 3 (wrapped to -106)\n",
+		"main.go:13:11: overflow check always fails: int8 addition overflow: 100 + 100 (wrapped to -56)\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Count(out, "always fails") != 2 {
+		t.Errorf("unexpected diagnostics in output:\n%s", out)
+	}
+}
@@ -0,0 +1,166 @@
+package tests
+
+import (
+	"strings"
+	"testing"
+)
+
+// addIgnored is small enough to be inlined into its callers,
+// which must not bring back the suppressed check.
+func addIgnored(a, b int8) int8 {
+	//panikint:ignore overflow
+	return a + b
+}
+
+//go:nooverflowcheck
+func mulUnchecked(a, b int8) int8 {
+	a *= b
+	return a
+}
+
+func addIgnoredGeneric[T int8 | int16](a, b T) T {
+	a += b //panikint:ignore overflow
+	return a
+}
+
+func TestOverflowSuppressionInlined(t *testing.T) {
+	if got := addIgnored(127, 1); got != -128 {
+		t.Fatalf("Expected -128, got %d", got)
+	}
+	// The directive applies to addIgnored only, not to its callers.
+	var a int8 = 127
+	recoverArithmeticError(t, func() { _ = addIgnored(a, 0) + 1 })
+}
+
+func TestOverflowSuppressionFunction(t *testing.T) {
+	if got := mulUnchecked(100, 100); got != 16 {
+		t.Fatalf("Expected 16, got %d", got)
+	}
+}
+
+func TestOverflowSuppressionGeneric(t *testing.T) {
+	if got := addIgnoredGeneric[int8](127, 1); got != -128 {
+		t.Fatalf("Expected -128, got %d", got)
+	}
+	if got := addIgnoredGeneric[int16](32767, 1); got != -32768 {
+		t.Fatalf("Expected -32768, got %d", got)
+	}
+}
+
+func TestOverflowSuppressionClosure(t *testing.T) {
+	var a int8 = 127
+	//panikint:ignore overflow
+	inc := func() int8 { return a + 1 }
+	if got := inc(); got != -128 {
+		t.Fatalf("Expected -128, got %d", got)
+	}
+}
+
+func TestOverflowSuppressionIgnoresStrings(t *testing.T) {
+	var a int8 = 127
+	recoverArithmeticError(t, func() {
+		_ = a + int8(len("//panikint:ignore overflow overflow_false_positive"))
+	})
+}
+
+func TestOverflowSuppressionFile(t *testing.T) {
+	const src = `//panikint:ignore overflow
+
+package main
+
+var a, b int8 = 127, 1
+
+var c = a + b
+
+func main() {
+	println(a+b, c)
+}
+`
+	out, err := probeCommand(t, src, "run", ".").CombinedOutput()
+	if err != nil {
+		t.Fatalf("run failed: %v\n%s", err, out)
+	}
+	if got := string(out); got != "-128 -128\n" {
+		t.Errorf("got output %q, want %q", got, "-128 -128\n")
+	}
+}
+
+func TestSuppressionDirectiveErrors(t *testing.T) {
+	for _, tt := range []struct {
+		directive, want string
+	}{
+		{"//panikint:ignore", "main.go:4:4: usage: //panikint:ignore overflow|truncation..."},
+		{"//panikint:ignore wraparound", `main.go:4:4: unknown check "wraparound" in //panikint:ignore directive`},
+		{"//panikint:frob", "main.go:4:4: unknown directive //panikint:frob"},
+		{"//panikint:ignore overflow\n", "main.go:4:4: misplaced //panikint:ignore directive"},
+	} {
+		src := "package main\n\nfunc main() {\n\t" + tt.directive + "\n\tprintln()\n}\n"
+		out, err := buildOutput(t, src)
+		if err == nil {
+			t.Errorf("%s: build succeeded, want error", tt.directive)
+			continue
+		}
+		if !strings.Contains(out, tt.want) {
+			t.Errorf("%s: missing %q in output:\n%s", tt.directive, tt.want, out)
+		}
+	}
+}
+
+func TestStaleSuppressionDirectives(t *testing.T) {
+	const src = `package main
+
+func add(a, b int8) int8 {
+	//panikint:ignore overflow
+	return a + b
+}
+
+func conv(x int64) int32 {
+	return int32(x) //panikint:ignore truncation
+}
+
+func length(s string) int {
+	//panikint:ignore overflow
+	return len(s)
+}
+
+//go:nooverflowcheck
+func concat(a, b string) string { return a + b }
+
+func addGeneric[T int8 | int16](a, b T) T {
+	//panikint:ignore overflow
+	return a + b
+}
+
+func main() {
+	println(add(1, 2), conv(3), length("x"), concat("a", "b"))
+}
+`
+	stale := []string{
+		"main.go:13:4: stale //panikint:ignore directive: no overflow checks to suppress\n",
+		"main.go:17:3: stale //go:nooverflowcheck directive: no overflow checks to suppress\n",
+	}
+	for _, tt := range []struct {
+		flags   string
+		wantErr bool
+		want    []string
+	}{
+		{flags: "", want: append(stale, "main.go:9:20: stale //panikint:ignore directive: truncation checks are disabled\n")},
+		{flags: "-truncationdetect", want: stale},
+		{flags: "-truncationdetect -panikint.strict", wantErr: true, want: stale},
+	} {
+		out, err := buildOutput(t, src, "-gcflags="+tt.flags)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%q: build error %v, want error %v\n%s", tt.flags, err, tt.wantErr, out)
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(out, want) {
+				t.Errorf("%q: missing %q in output:\n%s", tt.flags, want, out)
+			}
+		}
+		// The directive of the uninstantiated generic function may
+		// well suppress checks in other packages.
+		if n := strings.Count(out, "stale"); n != len(tt.want) {
+			t.Errorf("%q: %d stale directives reported, want %d:\n%s", tt.flags, n, len(tt.want), out)
+		}
+	}
+}
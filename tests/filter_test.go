@@ -0,0 +1,173 @@
+package tests
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// filterProbeFiles is a main package with a generated file and a vendored
+// dependency, each of which overflows an int8.
+var filterProbeFiles = map[string]string{
+	"go.mod": "module example.com/probe\n\ngo 1.25\n\nrequire example.com/dep v1.0.0\n",
+	"vendor/modules.txt": `# example.com/dep v1.0.0
+## explicit
+example.com/dep
+`,
+	"vendor/example.com/dep/dep.go": `package dep
+
+func Add(a, b int8) int8 { return a + b }
+`,
+	"tables_gen.go": `package main
+
+func genAdd(a, b int8) int8 { return a + b }
+`,
+	"main.go": `package main
+
+import (
+	"os"
+
+	"example.com/dep"
+)
+
+func add(a, b int8) int8 { return a + b }
+
+func main() {
+	var a, b int8 = 127, 1
+	switch os.Args[1] {
+	case "dep":
+		println(dep.Add(a, b))
+	case "gen":
+		println(genAdd(a, b))
+	case "main":
+		println(add(a, b))
+	}
+}
+`,
+}
+
+// runFilterProbe builds filterProbeFiles with the -gcflags value gcflags
+// and reports, for each of the dep, gen and main cases, whether its
+// addition was checked.
+func runFilterProbe(t *testing.T, gcflags string) (dep, gen, main bool) {
+	t.Helper()
+	dir := t.TempDir()
+	for name, src := range filterProbeFiles {
+		file := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(file), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exe := filepath.Join(dir, "probe.exe")
+	cmd := exec.Command(filepath.Join(runtime.GOROOT(), "bin", "go"), "build", "-mod=vendor", "-gcflags="+gcflags, "-o", exe, ".")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("build with -gcflags=%s failed: %v\n%s", gcflags, err, out)
+	}
+	checked := func(which string) bool {
+		out, err := exec.Command(exe, which).CombinedOutput()
+		if err == nil {
+			if string(out) != "-128\n" {
+				t.Errorf("%s: got output %q, want %q", which, out, "-128\n")
+			}
+			return false
+		}
+		if !strings.Contains(string(out), "int8 addition overflow") {
+			t.Errorf("%s: %v\n%s", which, err, out)
+		}
+		return true
+	}
+	return checked("dep"), checked("gen"), checked("main")
+}
+
+func TestInstrumentationFilters(t *testing.T) {
+	for _, tt := range []struct {
+		gcflags         string
+		dep, gen, mainc bool
+	}{
+		{"", false, true, true},
+		// Inlined code is matched as part of the package it comes from,
+		// with the flags of the package it is inlined into.
+		{"example.com/dep=-panikint.include=example.com/dep", false, true, true},
+		{"example.com/dep=-panikint.include=example.com/dep -l", true, true, true},
+		{"-panikint.include=example.com/...", true, true, true},
+		{"-panikint.include=example.com/dep -l", false, true, true},
+		{"-panikint.exclude=*_gen.go", false, false, true},
+		// The go command compiles main packages as package main.
+		{"-panikint.exclude=main", false, false, false},
+		{"example.com/...=-panikint.include=vendor/example.com/dep/*.go", true, true, true},
+		{"example.com/...=-panikint.include=example.com/... -panikint.exclude=example.com/dep,tables_gen.go", false, false, true},
+	} {
+		dep, gen, mainc := runFilterProbe(t, tt.gcflags)
+		if dep != tt.dep || gen != tt.gen || mainc != tt.mainc {
+			t.Errorf("-gcflags=%s: checked dep, gen, main = %v, %v, %v; want %v, %v, %v",
+				tt.gcflags, dep, gen, mainc, tt.dep, tt.gen, tt.mainc)
+		}
+	}
+}
+
+func TestInstrumentationFilterErrors(t *testing.T) {
+	out, err := buildOutput(t, "package main\n\nfunc main() {}\n", "-gcflags=-panikint.include=[*.go")
+	const want = `-panikint.include: malformed file glob "[*.go"`
+	if err == nil || !strings.Contains(out, want) {
+		t.Errorf("got %v, want an error with %q in output:\n%s", err, want, out)
+	}
+}
+
+// Copies of encoding/binary truncate on purpose, so their integer
+// conversions are not checked by default. Their arithmetic is.
+func TestInstrumentationFilterEncodingBinary(t *testing.T) {
+	const src = `package main
+
+import (
+	"os"
+
+	"example.com/probe/encoding/binary"
+)
+
+func main() {
+	if os.Args[1] == "trunc" {
+		println(binary.Trunc(300))
+	} else {
+		println(binary.Add(127, 1))
+	}
+}
+`
+	const binarySrc = `package binary
+
+func Trunc(x int64) int8 { return int8(x) }
+
+func Add(a, b int8) int8 { return a + b }
+`
+	for _, tt := range []struct {
+		gcflags, trunc string
+	}{
+		{"-gcflags=all=-truncationdetect", "44\n"},
+		{"-gcflags=all=-truncationdetect -panikint.include=example.com/probe/...", "int64(300) cannot fit in int8"},
+	} {
+		cmd := probeCommand(t, src, "build", tt.gcflags, "-o", "probe.exe", ".")
+		dir := filepath.Join(cmd.Dir, "encoding", "binary")
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, "binary.go"), []byte(binarySrc), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("build with %s failed: %v\n%s", tt.gcflags, err, out)
+		}
+		exe := filepath.Join(cmd.Dir, "probe.exe")
+		for which, want := range map[string]string{"trunc": tt.trunc, "add": "int8 addition overflow"} {
+			out, _ := exec.Command(exe, which).CombinedOutput()
+			if !strings.Contains(string(out), want) {
+				t.Errorf("%s with %s: missing %q in output:\n%s", which, tt.gcflags, want, out)
+			}
+		}
+	}
+}
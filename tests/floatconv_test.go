@@ -0,0 +1,129 @@
+package tests
+
+import (
+	"errors"
+	"math"
+	"runtime"
+	"runtime/panikint"
+	"strings"
+	"testing"
+)
+
+// skipIfFloatConvDisabled skips the test if float conversion detection is disabled
+func skipIfFloatConvDisabled(t *testing.T) {
+	if !panikint.Enabled(panikint.FloatConv) {
+		t.Skip("Skipping float conversion test - float conversion detection is disabled, build with -panikint=floatconv")
+	}
+}
+
+func TestFloatConversionMessages(t *testing.T) {
+	skipIfFloatConvDisabled(t)
+	nan, inf := math.NaN(), math.Inf(1)
+	var big float64 = 1e10
+	var f32 float32 = 128.5
+	var neg float64 = -1
+	expectPanicMessage(t, "runtime error: float64(NaN) cannot fit in int", func() {
+		_ = int(nan)
+	})
+	expectPanicMessage(t, "runtime error: float64(+Inf) cannot fit in int64", func() {
+		_ = int64(inf)
+	})
+	expectPanicMessage(t, "runtime error: float64(-Inf) cannot fit in uint8", func() {
+		_ = uint8(-inf)
+	})
+	expectPanicMessage(t, "runtime error: float64(1e+10) cannot fit in int32", func() {
+		_ = int32(big)
+	})
+	expectPanicMessage(t, "runtime error: float32(128.5) cannot fit in int8", func() {
+		_ = int8(f32)
+	})
+	expectPanicMessage(t, "runtime error: float64(-1) cannot fit in uint", func() {
+		_ = uint(neg)
+	})
+}
+
+func TestFloatConversionBounds(t *testing.T) {
+	skipIfFloatConvDisabled(t)
+	for _, f := range []float64{
+		math.Ldexp(1, 63), math.Nextafter(-math.Ldexp(1, 63), math.Inf(-1)),
+	} {
+		recoverArithmeticError(t, func() { _ = int64(f) })
+	}
+	for _, f := range []float64{math.Ldexp(1, 64), -1} {
+		recoverArithmeticError(t, func() { _ = uint64(f) })
+	}
+	for _, f := range []float32{128, -129} {
+		recoverArithmeticError(t, func() { _ = int8(f) })
+	}
+}
+
+func TestFloatConversionKind(t *testing.T) {
+	skipIfFloatConvDisabled(t)
+	var f float32 = 70000
+	err := recoverArithmeticError(t, func() { _ = uint16(f) })
+	if err.Kind() != runtime.ArithmeticOutOfRange || !errors.Is(err, runtime.ErrOutOfRange) {
+		t.Errorf("uint16(%v): Kind() = %v, want out of range", f, err.Kind())
+	}
+	if x, y := err.Operands(); err.Op() != "conversion" || x != f || y != nil {
+		t.Errorf("got %s of %v and %v, want conversion of %v", err.Op(), x, y, f)
+	}
+	if err.SourceType() != "float32" || err.DestType() != "uint16" {
+		t.Errorf("got %s to %s, want float32 to uint16", err.SourceType(), err.DestType())
+	}
+}
+
+func TestSafeFloatConversion(t *testing.T) {
+	// These conversions should not panic
+	for _, tc := range []struct {
+		f    float64
+		want int64
+	}{
+		{-9223372036854775808, math.MinInt64},
+		{9223372036854774784, 9223372036854774784},
+		{-1.5, -1},
+		{0.99, 0},
+	} {
+		if got := int64(tc.f); got != tc.want {
+			t.Errorf("int64(%v) = %d, want %d", tc.f, got, tc.want)
+		}
+	}
+	var f8 float32 = -128.9
+	if got := int8(f8); got != -128 {
+		t.Errorf("int8(%v) = %d, want -128", f8, got)
+	}
+	var u float64 = -0.5
+	if got := uint32(u); got != 0 {
+		t.Errorf("uint32(%v) = %d, want 0", u, got)
+	}
+	var max float64 = math.Nextafter(math.Ldexp(1, 64), 0)
+	if got := uint64(max); got != 18446744073709549568 {
+		t.Errorf("uint64(%v) = %d, want 18446744073709549568", max, got)
+	}
+	// The result of an unchecked conversion depends on the architecture.
+	nan := math.NaN()
+	//panikint:ignore truncation
+	_ = int32(nan)
+}
+
+func TestFloatConversionFlag(t *testing.T) {
+	const src = `package main
+
+import "math"
+
+func main() {
+	f := -math.Inf(1)
+	println(uint8(f) == uint8(f))
+}
+`
+	out, err := probeCommand(t, src, "run", ".").CombinedOutput()
+	if err != nil || string(out) != "true\n" {
+		t.Errorf("by default: got %v, %q, want success and %q", err, out, "true\n")
+	}
+	const want = "panic: runtime error: float64(-Inf) cannot fit in uint8"
+	for _, flag := range []string{"-gcflags=-floatconvdetect", "-panikint=floatconv"} {
+		out, err = probeCommand(t, src, "run", flag, ".").CombinedOutput()
+		if err == nil || !strings.Contains(string(out), want) {
+			t.Errorf("with %s: got %v, want a panic with %q in output:\n%s", flag, err, want, out)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package tests
+
+import (
+	"errors"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"runtime/panikint"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+// skipIfInt32Disabled skips the test if the 32-bit portability checks
+// are disabled or ints are 32 bits wide anyway.
+func skipIfInt32Disabled(t *testing.T) {
+	if !panikint.Enabled(panikint.Int32) {
+		t.Skip("Skipping 32-bit portability test - build with -panikint=int32")
+	}
+	if unsafe.Sizeof(int(0)) != 8 {
+		t.Skip("Skipping 32-bit portability test - ints are 32 bits wide")
+	}
+}
+
+func TestInt32Messages(t *testing.T) {
+	skipIfInt32Disabled(t)
+	var a, one int = 1<<31 - 1, 1
+	expectPanicMessage(t, "runtime error: 32-bit int addition overflow: 2147483647 + 1 (wrapped to -2147483648)", func() {
+		_ = a + one
+	})
+	var u, v uint = 1 << 16, 1 << 16
+	expectPanicMessage(t, "runtime error: 32-bit uint multiplication overflow: 65536 * 65536 (wrapped to 0)", func() {
+		_ = u * v
+	})
+	var big int64 = 5000000000
+	expectPanicMessage(t, "runtime error: int64(5000000000) cannot fit in 32-bit int (truncated to 705032704)", func() {
+		_ = int(big)
+	})
+	var u32 uint32 = 4000000000
+	expectPanicMessage(t, "runtime error: uint32(4000000000) changes sign in 32-bit int (converted to -294967296)", func() {
+		_ = int(u32)
+	})
+	var f = 3e10
+	expectPanicMessage(t, "runtime error: float64(3e+10) cannot fit in 32-bit int", func() {
+		_ = int(f)
+	})
+}
+
+func TestInt32Kinds(t *testing.T) {
+	skipIfInt32Disabled(t)
+	var a, b int = -1 << 31, -1
+	err := recoverArithmeticError(t, func() { _ = a + b })
+	if err.Kind() != runtime.ArithmeticUnderflow || !errors.Is(err, runtime.ErrUnderflow) || !err.Portable32() {
+		t.Errorf("%d + %d: Kind() = %v, Portable32() = %v, want underflow and true", a, b, err.Kind(), err.Portable32())
+	}
+	if err.SourceType() != "int" || err.DestType() != "int" {
+		t.Errorf("got types %s and %s, want int", err.SourceType(), err.DestType())
+	}
+	err = recoverArithmeticError(t, func() { _ = a / b })
+	if err.Kind() != runtime.ArithmeticDivisionOverflow || !err.Portable32() {
+		t.Errorf("%d / %d: Kind() = %v, Portable32() = %v, want division overflow and true", a, b, err.Kind(), err.Portable32())
+	}
+}
+
+func TestSafeInt32(t *testing.T) {
+	skipIfInt32Disabled(t)
+	// These operations fit in 32 bits and should not panic
+	var a, b int = 1<<31 - 2, 1
+	if r := a + b; r != 1<<31-1 {
+		t.Fatalf("Expected %d, got %d", 1<<31-1, r)
+	}
+	var x int64 = -1 << 31
+	if r := int(x); r != -1<<31 {
+		t.Fatalf("Expected %d, got %d", -1<<31, r)
+	}
+	var u uint32 = 1<<32 - 1
+	if r := uint(u); r != 1<<32-1 {
+		t.Fatalf("Expected %d, got %d", uint32(1<<32-1), r)
+	}
+	// Other integer types are not concerned
+	var i64 int64 = 1 << 31
+	if r := i64 * 2; r != 1<<32 {
+		t.Fatalf("Expected %d, got %d", int64(1<<32), r)
+	}
+	var c int = 1 << 20
+	//panikint:ignore overflow
+	if r := c * c; r != 1<<40 {
+		t.Fatalf("Expected %d, got %d", 1<<40, r)
+	}
+}
+
+func TestInt32DetectionFlag(t *testing.T) {
+	if unsafe.Sizeof(int(0)) != 8 {
+		t.Skip("ints are 32 bits wide")
+	}
+	const src = `package main
+
+func main() {
+	var a int = 1 << 20
+	println(a * a)
+}
+`
+	out, err := probeCommand(t, src, "run", ".").CombinedOutput()
+	if err != nil || string(out) != "1099511627776\n" {
+		t.Errorf("without -int32detect: got %v, %q, want success and %q", err, out, "1099511627776\n")
+	}
+	out, err = probeCommand(t, src, "run", "-gcflags=-int32detect", ".").CombinedOutput()
+	const want = "panic: runtime error: 32-bit int multiplication overflow: 1048576 * 1048576 (wrapped to 0)"
+	if err == nil || !strings.Contains(string(out), want) {
+		t.Errorf("with -int32detect: got %v, want a panic with %q in output:\n%s", err, want, out)
+	}
+}
+
+// The 32-bit checks of an operation are only inserted when the checks
+// of its class are enabled, since a 32-bit target would wrap silently
+// where those are disabled.
+func TestInt32DisabledClasses(t *testing.T) {
+	if unsafe.Sizeof(int(0)) != 8 {
+		t.Skip("ints are 32 bits wide")
+	}
+	const src = `package main
+
+import "os"
+
+func main() {
+	var x uint = 1 << 40
+	var n int = 1
+	var big int64 = 5000000000
+	var f = 3e10
+	println(x&-x == x, n<<40, int(big), int(f))
+	if len(os.Args) > 1 {
+		var a int = 1 << 20
+		println(a * a)
+	}
+}
+`
+	cmd := probeCommand(t, src, "build", "-panikint=overflow,int32", "-o", "probe.exe", ".")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	exe := filepath.Join(cmd.Dir, "probe.exe")
+	out, err := exec.Command(exe).CombinedOutput()
+	if want := "true 1099511627776 5000000000 30000000000\n"; err != nil || string(out) != want {
+		t.Errorf("with negation, shift, truncation and floatconv disabled: got %v, %q, want success and %q", err, out, want)
+	}
+	out, err = exec.Command(exe, "mul").CombinedOutput()
+	const want = "panic: runtime error: 32-bit int multiplication overflow: 1048576 * 1048576 (wrapped to 0)"
+	if err == nil || !strings.Contains(string(out), want) {
+		t.Errorf("with overflow enabled: got %v, want a panic with %q in output:\n%s", err, want, out)
+	}
+}
@@ -0,0 +1,133 @@
+package tests
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+// probeCommand writes src as the only file of a throwaway main package and
+// returns a command running the go tool under test on it with args.
+func probeCommand(t *testing.T, src string, args ...string) *exec.Cmd {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module example.com/probe\n\ngo 1.25\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "main.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	goCmd := filepath.Join(runtime.GOROOT(), "bin", "go")
+	cmd := exec.Command(goCmd, args...)
+	cmd.Dir = dir
+	return cmd
+}
+
+// buildOutput compiles src as the only file of a throwaway main package using
+// the toolchain under test and returns the combined compiler output.
+func buildOutput(t *testing.T, src string, args ...string) (string, error) {
+	t.Helper()
+	cmd := probeCommand(t, src, append([]string{"build", "-o", os.DevNull}, append(args, ".")...)...)
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
+
+func skipIfNoCheckedOps(t *testing.T) {
+	switch runtime.GOARCH {
+	case "amd64", "arm64", "riscv64":
+	default:
+		t.Skip("checked arithmetic ops are not lowered on " + runtime.GOARCH)
+	}
+}
+
+func TestProveRemovesBoundedChecks(t *testing.T) {
+	skipIfNoCheckedOps(t)
+	const src = `package main
+
+func mask(x int) int { return x&0x7f + 1 }
+
+func sum(s []int) (t int) {
+	for i := 0; i < len(s); i++ {
+		t ^= s[i]
+	}
+	return t
+}
+
+func loop(n uint8) (s uint8) {
+	for i := uint8(0); i < n; i++ {
+		s ^= i
+	}
+	return s
+}
+
+func main() {
+	println(mask(3), sum(nil), loop(3))
+}
+`
+	out, err := buildOutput(t, src, "-gcflags=-d=ssa/prove/debug=1")
+	if err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	for _, want := range []string{
+		"main.go:3:38: Proved Add64over does not overflow",
+		"main.go:6:27: Proved Add64over does not overflow",
+		"main.go:7:9: Proved IsInBounds",
+		"main.go:13:29: Proved ZeroExt8to32 of Trunc32to8 is a no-op",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in prove output:\n%s", want, out)
+		}
+	}
+}
+
+func TestProveKeepsUnboundedChecks(t *testing.T) {
+	skipIfNoCheckedOps(t)
+	const src = `package main
+
+func add(a, b int) int { return a + b }
+
+func main() {
+	println(add(1, 2))
+}
+`
+	out, err := buildOutput(t, src, "-gcflags=-d=ssa/prove/debug=1")
+	if err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	if strings.Contains(out, "does not overflow") {
+		t.Errorf("unexpected proof for unbounded addition:\n%s", out)
+	}
+}
+
+func TestBoundedArithmetic(t *testing.T) {
+	// These operations should not panic
+	var n uint8 = 255
+	var count int
+	for i := uint8(0); i < n; i++ {
+		count++
+	}
+	if count != 255 {
+		t.Fatalf("Expected 255 iterations, got %d", count)
+	}
+	var x uint8 = 0xff
+	if r := x&0x7f + 1; r != 0x80 {
+		t.Fatalf("Expected %d, got %d", 0x80, r)
+	}
+	var y int = -1
+	if r := y&0x7f + 1; r != 0x80 {
+		t.Fatalf("Expected %d, got %d", 0x80, r)
+	}
+}
+
+func TestUnboundedMaskOverflow(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("Expected panic for uint8 overflow after a no-op mask")
+		}
+	}()
+	x := opaque(uint8(0xff))
+	_ = x&0xff + 1
+}
@@ -0,0 +1,310 @@
+package tests
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"runtime/panikint"
+	"strings"
+	"testing"
+)
+
+//panikint:range 0..100
+type percent int
+
+//panikint:range -10..10
+type offset int8
+
+//panikint:range 1024..65535
+type port uint16
+
+func doubleGeneric[T ~int | ~int8](x int) T { return T(x) * 2 }
+
+type rangeBox[T ~int | ~int8] struct{ v T }
+
+func (b rangeBox[T]) double() T { return b.v * 2 }
+
+func TestRangeMessages(t *testing.T) {
+	var n, m percent = 60, 50
+	var big = 150
+	var f = 3.5e2
+	expectPanicMessage(t, "runtime error: tests.percent(150) out of range 0..100", func() {
+		_ = percent(big)
+	})
+	expectPanicMessage(t, "runtime error: tests.percent(350) out of range 0..100", func() {
+		_ = percent(f)
+	})
+	expectPanicMessage(t, "runtime error: tests.percent addition result 110 out of range 0..100", func() {
+		_ = n + m
+	})
+	expectPanicMessage(t, "runtime error: tests.percent subtraction result -10 out of range 0..100", func() {
+		_ = m - n
+	})
+	expectPanicMessage(t, "runtime error: tests.percent negation result -60 out of range 0..100", func() {
+		_ = -n
+	})
+	expectPanicMessage(t, "runtime error: tests.percent addition result 101 out of range 0..100", func() {
+		n += 41
+	})
+	var o offset = 5
+	expectPanicMessage(t, "runtime error: tests.offset multiplication result 15 out of range -10..10", func() {
+		_ = o * 3
+	})
+	var p port = 2000
+	expectPanicMessage(t, "runtime error: tests.port subtraction result 1000 out of range 1024..65535", func() {
+		_ = p - 1000
+	})
+	expectPanicMessage(t, "runtime error: tests.percent multiplication result 150 out of range 0..100", func() {
+		_ = doubleGeneric[percent](opaque(75))
+	})
+	expectPanicMessage(t, "runtime error: tests.percent multiplication result 150 out of range 0..100", func() {
+		_ = rangeBox[percent]{opaque(percent(75))}.double()
+	})
+}
+
+func TestRangeConversions(t *testing.T) {
+	// The operand of a conversion is checked, not the value it wraps or
+	// truncates to.
+	var neg, u, wide = -1, uint32(70000), int64(1<<32 + 2000)
+	expectPanicMessage(t, "runtime error: tests.port(-1) out of range 1024..65535", func() {
+		_ = port(neg)
+	})
+	expectPanicMessage(t, "runtime error: tests.port(70000) out of range 1024..65535", func() {
+		_ = port(u)
+	})
+	expectPanicMessage(t, "runtime error: tests.port(4294969296) out of range 1024..65535", func() {
+		_ = port(wide)
+	})
+	var big uint64 = 1 << 63
+	expectPanicMessage(t, "runtime error: tests.offset(9223372036854775808) out of range -10..10", func() {
+		_ = offset(big)
+	})
+	var f = 1e20
+	expectPanicMessage(t, "runtime error: tests.percent(1e+20) out of range 0..100", func() {
+		_ = percent(f)
+	})
+	var in, small, frac = 2000, int8(-10), float32(-10.5)
+	if got := port(in); got != 2000 {
+		t.Errorf("port(%d) = %d, want 2000", in, got)
+	}
+	if got := offset(small); got != -10 {
+		t.Errorf("offset(%d) = %d, want -10", small, got)
+	}
+	if got := offset(frac); got != -10 {
+		t.Errorf("offset(%v) = %d, want -10", frac, got)
+	}
+}
+
+func TestRangeOperations(t *testing.T) {
+	var n percent = 60
+	var o offset = 5
+	var p port = 2000
+	expectPanicMessage(t, "runtime error: tests.port remainder result 0 out of range 1024..65535", func() {
+		_ = p % 1000
+	})
+	expectPanicMessage(t, "runtime error: tests.port right shift result 125 out of range 1024..65535", func() {
+		_ = p >> 4
+	})
+	expectPanicMessage(t, "runtime error: tests.port bitwise and result 208 out of range 1024..65535", func() {
+		_ = p & 0xff
+	})
+	expectPanicMessage(t, "runtime error: tests.percent bitwise or result 124 out of range 0..100", func() {
+		_ = n | 0x40
+	})
+	expectPanicMessage(t, "runtime error: tests.offset bitwise xor result 21 out of range -10..10", func() {
+		_ = o ^ 0x10
+	})
+	expectPanicMessage(t, "runtime error: tests.port bit clear result 976 out of range 1024..65535", func() {
+		_ = p &^ 0x400
+	})
+	expectPanicMessage(t, "runtime error: tests.percent bitwise complement result -61 out of range 0..100", func() {
+		_ = ^n
+	})
+	expectPanicMessage(t, "runtime error: tests.port right shift result 1000 out of range 1024..65535", func() {
+		p >>= 1
+	})
+	if got := n%7 + n&0x3c + n>>1; got != 4+60+30 {
+		t.Errorf("n%%7 + n&0x3c + n>>1 = %d, want 94", got)
+	}
+}
+
+func TestRangeError(t *testing.T) {
+	var n percent = 60
+	err := recoverArithmeticError(t, func() { _ = n * 2 })
+	if err.Kind() != runtime.ArithmeticRangeViolation || !errors.Is(err, runtime.ErrRangeViolation) {
+		t.Errorf("Kind() = %v, want range violation", err.Kind())
+	}
+	if lo, hi := err.Range(); lo != 0 || hi != 100 {
+		t.Errorf("Range() = %v, %v, want 0, 100", lo, hi)
+	}
+	if x, y := err.Operands(); x != 120 || y != nil {
+		t.Errorf("Operands() = %v, %v, want 120, nil", x, y)
+	}
+	if err.SourceType() != "tests.percent" || err.DestType() != "tests.percent" || err.Op() != "multiplication" {
+		t.Errorf("got %s %s to %s, want multiplication of tests.percent", err.Op(), err.SourceType(), err.DestType())
+	}
+	var u uint32 = 80
+	err = recoverArithmeticError(t, func() { _ = port(u) })
+	if lo, hi := err.Range(); lo != uint16(1024) || hi != uint16(65535) {
+		t.Errorf("Range() = %v, %v, want 1024, 65535", lo, hi)
+	}
+	if err.SourceType() != "uint32" || err.Op() != "conversion" {
+		t.Errorf("got %s of %s, want conversion of uint32", err.Op(), err.SourceType())
+	}
+	if x, y := err.Operands(); x != u || y != nil {
+		t.Errorf("Operands() = %v, %v, want %v, nil", x, y, u)
+	}
+	err = recoverArithmeticError(t, func() { _ = n + 100 })
+	if lo, hi := err.Range(); err.Kind() != runtime.ArithmeticRangeViolation || lo == nil || hi == nil {
+		t.Errorf("Kind() = %v, Range() = %v, %v", err.Kind(), lo, hi)
+	}
+}
+
+func TestRangeInRange(t *testing.T) {
+	if !panikint.Enabled(panikint.Range) {
+		t.Errorf("panikint.Enabled(panikint.Range) = false, want true")
+	}
+	var n, m percent = 40, 50
+	if got := n + m - 10; got != 80 {
+		t.Errorf("n + m - 10 = %d, want 80", got)
+	}
+	var o offset = -5
+	if got := o * 2; got != -10 {
+		t.Errorf("o * 2 = %d, want -10", got)
+	}
+	var p port = 65534
+	if got := p + 1; got != 65535 {
+		t.Errorf("p + 1 = %d, want 65535", got)
+	}
+	if got := doubleGeneric[percent](50); got != 100 {
+		t.Errorf("doubleGeneric[percent](50) = %d, want 100", got)
+	}
+	// The range applies to its type alone, even in the same generic function.
+	if got := doubleGeneric[int](100); got != 200 {
+		t.Errorf("doubleGeneric[int](100) = %d, want 200", got)
+	}
+	if got := (rangeBox[percent]{50}).double(); got != 100 {
+		t.Errorf("rangeBox[percent]{50}.double() = %d, want 100", got)
+	}
+	if got := (rangeBox[int]{100}).double(); got != 200 {
+		t.Errorf("rangeBox[int]{100}.double() = %d, want 200", got)
+	}
+	// The zero value is not checked.
+	var q port
+	if q != 0 {
+		t.Errorf("q = %d, want 0", q)
+	}
+	// Neither is the arithmetic a directive suppresses.
+	//panikint:ignore overflow
+	if got := n + m + 20; got != 110 {
+		t.Errorf("n + m + 20 = %d, want 110", got)
+	}
+}
+
+func TestRangeOtherPackage(t *testing.T) {
+	const src = `package main
+
+import (
+	"fmt"
+
+	"example.com/probe/lvl"
+)
+
+func main() {
+	var l lvl.Level = 9
+	fmt.Println(lvl.Up(l))
+	fmt.Println(lvl.Up(lvl.Up(l)))
+}
+`
+	cmd := probeCommand(t, src, "run", ".")
+	if err := os.Mkdir(filepath.Join(cmd.Dir, "lvl"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	const lvlSrc = `package lvl
+
+// Level is a volume level.
+//
+//panikint:range 0..10
+type Level uint8
+
+func Up(l Level) Level { return l + 1 }
+`
+	if err := os.WriteFile(filepath.Join(cmd.Dir, "lvl", "lvl.go"), []byte(lvlSrc), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out, err := cmd.CombinedOutput()
+	const want = "10\npanic: runtime error: lvl.Level addition result 11 out of range 0..10"
+	if err == nil || !strings.Contains(string(out), want) {
+		t.Errorf("got %v, want a panic and %q in output:\n%s", err, want, out)
+	}
+}
+
+func TestRangeConstants(t *testing.T) {
+	const src = `package main
+
+//panikint:range 1..100
+type percent int
+
+const full percent = 150
+
+var v = percent(-1) + 5
+
+var q percent = 500
+
+func f(p percent) percent { return p }
+
+func main() {
+	var p percent = 50
+	println(p+0, p*2, full, v, q, f(300), p+percent(200), p == 0)
+	switch p {
+	case 0:
+	}
+	p -= 1000
+}
+`
+	out, err := buildOutput(t, src)
+	if err == nil {
+		t.Fatalf("build succeeded, want error")
+	}
+	for _, want := range []string{
+		"main.go:6:7: constant 150 out of range 1..100 of main.percent",
+		"main.go:8:16: constant -1 out of range 1..100 of main.percent",
+		"main.go:10:17: constant 500 out of range 1..100 of main.percent",
+		"main.go:16:34: constant 300 out of range 1..100 of main.percent",
+		"main.go:16:49: constant 200 out of range 1..100 of main.percent",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("missing %q in output:\n%s", want, out)
+		}
+	}
+	// The operands of operators are not values of the range, and the
+	// uses of full are not reported again.
+	if n := strings.Count(out, "out of range"); n != 5 {
+		t.Errorf("%d constants reported out of range, want 5:\n%s", n, out)
+	}
+}
+
+func TestRangeDirectiveErrors(t *testing.T) {
+	for _, tt := range []struct {
+		decl, want string
+	}{
+		{"//panikint:range 0..300\ntype t uint8\n", "main.go:3:3: bound 300 overflows uint8 in //panikint:range directive"},
+		{"//panikint:range 10..1\ntype t int\n", "main.go:3:3: empty range 10..1 in //panikint:range directive"},
+		{"//panikint:range x..1\ntype t int\n", `main.go:3:3: invalid bound "x" in //panikint:range directive`},
+		{"//panikint:range 1-2\ntype t int\n", "main.go:3:3: usage: //panikint:range lo..hi"},
+		{"//panikint:range 1..2\n//panikint:range 1..3\ntype t int\n", "main.go:4:3: repeated //panikint:range directive"},
+		{"//panikint:range 0..1\ntype t string\n", "main.go:3:3: //panikint:range directive on non-integer type t"},
+		{"//panikint:range 0..1\nvar v int\n", "main.go:3:3: misplaced //panikint:range directive"},
+	} {
+		src := "package main\n\n" + tt.decl + "\nfunc main() {}\n"
+		out, err := buildOutput(t, src)
+		if err == nil {
+			t.Errorf("%q: build succeeded, want error", tt.decl)
+			continue
+		}
+		if !strings.Contains(out, tt.want) {
+			t.Errorf("%q: missing %q in output:\n%s", tt.decl, tt.want, out)
+		}
+	}
+}
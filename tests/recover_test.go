@@ -0,0 +1,74 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const recoverProbe = `package main
+
+//go:noinline
+func add(a, b int8) int8 { return a + b }
+
+func main() {
+	var s int8
+	for i := 0; i < 3; i++ {
+		s = add(127, int8(i))
+	}
+	var u uint16 = 300
+	println(s, u*u)
+}
+`
+
+// runRecoverProbe runs recoverProbe built with -arithrecover and the
+// extra environment env, and returns its standard output and error.
+func runRecoverProbe(t *testing.T, env ...string) (stdout, stderr string) {
+	t.Helper()
+	cmd := probeCommand(t, recoverProbe, "run", "-gcflags=-arithrecover", ".")
+	cmd.Env = append(os.Environ(), env...)
+	var outBuf, errBuf strings.Builder
+	cmd.Stdout, cmd.Stderr = &outBuf, &errBuf
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("run failed: %v\n%s", err, errBuf.String())
+	}
+	return outBuf.String(), errBuf.String()
+}
+
+func checkRecoverReport(t *testing.T, report string) {
+	t.Helper()
+	for _, want := range []string{
+		"panikint: integer overflow at ",
+		"main.go:4:37: int8 addition overflow: 127 + 1 (wrapped to -128)\nmain.add\n\t",
+		"main.go:12:14: uint16 multiplication overflow: 300 * 300 (wrapped to 24464)\nmain.main\n\t",
+	} {
+		if !strings.Contains(report, want) {
+			t.Errorf("missing %q in report:\n%s", want, report)
+		}
+	}
+	if n := strings.Count(report, "int8 addition overflow"); n != 1 {
+		t.Errorf("int8 addition overflow reported %d times, want once:\n%s", n, report)
+	}
+}
+
+func TestRecoverContinuesWithWrappedValue(t *testing.T) {
+	_, stderr := runRecoverProbe(t)
+	if !strings.Contains(stderr, "-127 24464\n") {
+		t.Errorf("program did not run to completion with wrapped values:\n%s", stderr)
+	}
+	checkRecoverReport(t, stderr)
+}
+
+func TestRecoverReportFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "report.txt")
+	_, stderr := runRecoverProbe(t, "GOPANIKINT_REPORT="+file)
+	if strings.Contains(stderr, "panikint:") {
+		t.Errorf("report written to standard error instead of %s:\n%s", file, stderr)
+	}
+	report, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	checkRecoverReport(t, string(report))
+}
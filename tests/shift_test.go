@@ -0,0 +1,87 @@
+package tests
+
+import (
+	"errors"
+	"runtime"
+	"runtime/panikint"
+	"strings"
+	"testing"
+)
+
+// skipIfShiftDisabled skips the test if shift detection is disabled
+func skipIfShiftDisabled(t *testing.T) {
+	if !panikint.Enabled(panikint.Shift) {
+		t.Skip("Skipping shift test - shift detection is disabled, build with -panikint=shift")
+	}
+}
+
+func TestShiftMessageOperands(t *testing.T) {
+	skipIfShiftDisabled(t)
+	var a8 int8 = 64
+	var n uint = 1
+	expectPanicMessage(t, "runtime error: int8 left shift overflow: 64 << 1 (wrapped to -128)", func() {
+		_ = a8 << n
+	})
+	var u16 uint16 = 0x8001
+	expectPanicMessage(t, "runtime error: uint16 left shift overflow: 32769 << 1 (wrapped to 2)", func() {
+		_ = u16 << n
+	})
+	var one uint64 = 1
+	var big int = 64
+	expectPanicMessage(t, "runtime error: uint64 left shift overflow: 1 << 64 (wrapped to 0)", func() {
+		_ = one << big
+	})
+}
+
+func TestShiftKinds(t *testing.T) {
+	skipIfShiftDisabled(t)
+	var neg int32 = -1 << 30
+	err := recoverArithmeticError(t, func() { _ = neg << 2 })
+	if err.Kind() != runtime.ArithmeticUnderflow || !errors.Is(err, runtime.ErrUnderflow) {
+		t.Errorf("%d << 2: Kind() = %v, want underflow", neg, err.Kind())
+	}
+	if x, y := err.Operands(); err.Op() != "left shift" || x != neg || y != uint64(2) {
+		t.Errorf("got %s of %v and %v, want left shift of %d and 2", err.Op(), x, y, neg)
+	}
+}
+
+func TestSafeShift(t *testing.T) {
+	// These operations should not panic
+	var a int8 = -1
+	if r := a << 7; r != -128 {
+		t.Fatalf("Expected -128, got %d", r)
+	}
+	var u uint32 = 1
+	if r := u << 31; r != 1<<31 {
+		t.Fatalf("Expected %d, got %d", uint32(1<<31), r)
+	}
+	var z uint8
+	var n uint = 100
+	if r := z << n; r != 0 {
+		t.Fatalf("Expected 0, got %d", r)
+	}
+	var c uint8 = 0x81
+	//panikint:ignore overflow
+	if r := c << 1; r != 2 {
+		t.Fatalf("Expected 2, got %d", r)
+	}
+}
+
+func TestShiftDetectionFlag(t *testing.T) {
+	const src = `package main
+
+func main() {
+	var a uint8 = 0x81
+	println(a << 1)
+}
+`
+	out, err := probeCommand(t, src, "run", ".").CombinedOutput()
+	if err != nil || string(out) != "2\n" {
+		t.Errorf("without -shiftdetect: got %v, %q, want success and %q", err, out, "2\n")
+	}
+	out, err = probeCommand(t, src, "run", "-gcflags=-shiftdetect", ".").CombinedOutput()
+	const want = "panic: runtime error: uint8 left shift overflow: 129 << 1 (wrapped to 2)"
+	if err == nil || !strings.Contains(string(out), want) {
+		t.Errorf("with -shiftdetect: got %v, want a panic with %q in output:\n%s", err, want, out)
+	}
+}
@@ -0,0 +1,76 @@
+package tests
+
+import (
+	"debug/panikint"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"testing"
+)
+
+func TestCheckSiteTable(t *testing.T) {
+	skipIfNoCheckedOps(t)
+	const src = `package main
+
+func add(a, b int8) int8 { return a + b }
+
+func mask(x int) int { return x&0x7f + 1 }
+
+func conv(x int64) int32 { return int32(x) }
+
+func main() {
+	println(mask(3), conv(4))
+	println(add(127, 1))
+}
+`
+	cmd := probeCommand(t, src, "build", "-gcflags=-l -truncationdetect", "-o", "probe", ".")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	exe := filepath.Join(cmd.Dir, "probe")
+	table, err := panikint.ReadFile(exe)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sites := make(map[string]*panikint.Site)
+	for i := range table.Sites {
+		if s := &table.Sites[i]; filepath.Base(s.File) == "main.go" {
+			sites[s.Func] = s
+		}
+	}
+	for fn, want := range map[string]string{
+		"main.add":  "main.go:3:37: overflow check on int8 addition",
+		"main.conv": "main.go:7:41: truncation check on int64 to int32 conversion",
+	} {
+		s := sites[fn]
+		if s == nil {
+			t.Errorf("no check site in %s", fn)
+			continue
+		}
+		if got := filepath.Base(s.File) + ":" + strconv.Itoa(s.Line) + ":" + strconv.Itoa(s.Col) + ": " + s.String(); got != want {
+			t.Errorf("check site in %s is %q, want %q", fn, got, want)
+		}
+		if s.PC != 0 && table.Lookup(s.PC+1) != s {
+			t.Errorf("Lookup(%#x) does not find the check site in %s", s.PC+1, fn)
+		}
+	}
+	if s := sites["main.mask"]; s != nil {
+		t.Errorf("unexpected check site %v in main.mask", s)
+	}
+
+	// The return address printed in the traceback maps back to the check.
+	out, err := exec.Command(exe).CombinedOutput()
+	if err == nil {
+		t.Fatalf("run succeeded, want overflow panic:\n%s", out)
+	}
+	m := regexp.MustCompile(`main\.add\(.*\)\n\t.*main\.go:3 \+0x([0-9a-f]+)`).FindSubmatch(out)
+	if m == nil {
+		t.Fatalf("no main.add frame in traceback:\n%s", out)
+	}
+	off, _ := strconv.ParseUint(string(m[1]), 16, 64)
+	if s := table.LookupFunc("main.add", off); s != sites["main.add"] {
+		t.Errorf("LookupFunc(main.add, %#x) = %v, want the int8 addition check", off, s)
+	}
+}
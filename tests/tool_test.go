@@ -0,0 +1,208 @@
+package tests
+
+import (
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+)
+
+const toolSource = `package main
+
+func add(a, b int8) int8 { return a + b }
+
+func conv(x int64) int32 { return int32(x) }
+
+func ignored(x uint8) uint8 {
+	//panikint:ignore overflow
+	return x * 3
+}
+
+func stale(x uint8) uint8 {
+	//panikint:ignore truncation
+	return x * 3
+}
+
+//go:nooverflowcheck
+func nocheck(a, b int16) int16 { return a - b }
+
+func f2i(f float64) int8 {
+	return int8(f) //panikint:ignore truncation
+}
+
+func main() {
+	println(add(1, 2), conv(4), ignored(5), stale(6), nocheck(7, 8), f2i(9))
+}
+`
+
+// runTool runs go tool panikint with args in dir.
+func runTool(t *testing.T, dir string, args ...string) (string, error) {
+	t.Helper()
+	cmd := exec.Command(filepath.Join(runtime.GOROOT(), "bin", "go"), append([]string{"tool", "panikint"}, args...)...)
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	return string(out), err
+}
+
+func TestToolSitesAndDiff(t *testing.T) {
+	skipIfNoCheckedOps(t)
+	cmd := probeCommand(t, toolSource, "build", "-gcflags=-l -truncationdetect", "-o", "old", ".")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	dir := cmd.Dir
+	cmd = exec.Command(cmd.Path, "build", "-gcflags=-l", "-o", "new", ".")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+
+	out, err := runTool(t, dir, "sites", "old")
+	if err != nil {
+		t.Fatalf("sites failed: %v\n%s", err, out)
+	}
+	for _, want := range []string{
+		"main.add+0x",
+		"main.go:3:37 ",
+		" overflow check on int8 addition\n",
+		" truncation check on int64 to int32 conversion\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("sites: missing %q in output:\n%s", want, out)
+		}
+	}
+
+	// A stripped binary has no symbol table to find the addresses in.
+	cmd = exec.Command(cmd.Path, "build", "-gcflags=-l", "-ldflags=-s", "-o", "stripped", ".")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	out, err = runTool(t, dir, "sites", "stripped")
+	if err != nil || !strings.Contains(out, "main.add+0x") || !strings.Contains(out, " overflow check on int8 addition\n") {
+		t.Errorf("sites of a stripped binary: %v\n%s", err, out)
+	}
+
+	out, err = runTool(t, dir, "diff", "old", "new")
+	if err == nil {
+		t.Errorf("diff succeeded, want exit status 1")
+	}
+	want := "main.go:5:41: main.conv: truncation check on int64 to int32 conversion\n"
+	if !strings.HasPrefix(out, "- ") || !strings.HasSuffix(out, want) || strings.Count(out, "\n") != 1 {
+		t.Errorf("diff output:\n%s\nwant one removed check ending in %q", out, want)
+	}
+
+	if out, err := runTool(t, dir, "diff", "old", "old"); err != nil || out != "" {
+		t.Errorf("diff of a binary with itself: %v\n%s", err, out)
+	}
+}
+
+func TestToolStats(t *testing.T) {
+	skipIfNoCheckedOps(t)
+	dir := probeCommand(t, toolSource).Dir
+	out, err := runTool(t, dir, "stats", "-gcflags=-l", ".")
+	if err == nil || !strings.Contains(out, "-gcflags build flag cannot be used") {
+		t.Errorf("stats with -gcflags: %v\n%s", err, out)
+	}
+	out, err = runTool(t, dir, "stats", "-panikint=all", ".")
+	if err != nil {
+		t.Fatalf("stats failed: %v\n%s", err, out)
+	}
+	// The checks of inlined functions are counted once, as found
+	// if any copy of them is.
+	for _, want := range []string{
+		"package  class       type             found  removed  suppressed  excluded\n",
+		"main     floatconv   float64 to int8  0      0        1           0\n",
+		"main     overflow    int16            0      0        1           0\n",
+		"main     overflow    uint8            1      0        1           0\n",
+		"main     truncation  int64 to int32   1      0        0           0\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("stats: missing %q in output:\n%s", want, out)
+		}
+	}
+}
+
+func TestToolSuppressions(t *testing.T) {
+	skipIfNoCheckedOps(t)
+	dir := probeCommand(t, toolSource).Dir
+	out, err := runTool(t, dir, "suppressions", ".")
+	if err != nil {
+		t.Fatalf("suppressions failed: %v\n%s", err, out)
+	}
+	want := `main.go:8: //panikint:ignore overflow: suppresses 1 check
+main.go:13: //panikint:ignore truncation: suppresses nothing
+main.go:17: //go:nooverflowcheck: suppresses 1 check
+main.go:21: //panikint:ignore truncation: suppresses nothing
+`
+	// The build also reports the stale directives.
+	if !strings.HasSuffix(out, want) {
+		t.Errorf("suppressions output:\n%s\nwant suffix:\n%s", out, want)
+	}
+
+	// Float conversion checks are suppressed as truncation checks.
+	out, err = runTool(t, dir, "suppressions", "-panikint=floatconv", ".")
+	if err != nil {
+		t.Fatalf("suppressions failed: %v\n%s", err, out)
+	}
+	want = "main.go:21: //panikint:ignore truncation: suppresses 1 check\n"
+	if !strings.HasSuffix(out, want) {
+		t.Errorf("suppressions -panikint=floatconv output:\n%s\nwant suffix:\n%s", out, want)
+	}
+}
+
+const toolGenericSource = `package main
+
+//panikint:range 0..200
+type Seq uint8
+
+func add[T ~uint8](a, b T) T { return a + b }
+
+func main() {
+	println(add[uint8](1, 2), add[Seq](3, 4))
+}
+`
+
+// Generic code names the types of its checks as other code does.
+func TestToolGenericTypeNames(t *testing.T) {
+	skipIfNoCheckedOps(t)
+	cmd := probeCommand(t, toolGenericSource, "build", "-gcflags=-l", "-o", "bin", ".")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("build failed: %v\n%s", err, out)
+	}
+	dir := cmd.Dir
+
+	out, err := runTool(t, dir, "sites", "bin")
+	if err != nil {
+		t.Fatalf("sites failed: %v\n%s", err, out)
+	}
+	for _, want := range []string{
+		" overflow check on uint8 addition\n",
+		" range check on main.Seq addition\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("sites: missing %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "check on go.shape") {
+		t.Errorf("sites: shape type names in output:\n%s", out)
+	}
+
+	out, err = runTool(t, dir, "stats", ".")
+	if err != nil {
+		t.Fatalf("stats failed: %v\n%s", err, out)
+	}
+	for _, want := range []string{
+		"main     overflow  main.Seq  1      0        0           0\n",
+		"main     overflow  uint8     1      0        0           0\n",
+		"main     range     main.Seq  1      0        0           0\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("stats: missing %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "go.shape") {
+		t.Errorf("stats: shape type names in output:\n%s", out)
+	}
+}
@@ -1,32 +1,18 @@
 package tests
 
 import (
+	"errors"
+	"math"
+	"math/bits"
+	"runtime"
+	"runtime/panikint"
 	"testing"
 )
 
-// isTruncationDetectionEnabled checks if truncation detection is enabled by attempting
-// a truncation that should panic if detection is enabled
-func isTruncationDetectionEnabled() bool {
-	panicked := false
-	func() {
-		defer func() {
-			if recover() != nil {
-				panicked = true
-			}
-		}()
-
-		// Try a simple truncation that should trigger detection if enabled
-		var test uint16 = 256
-		_ = uint8(test) // This should panic if truncation detection is on
-	}()
-
-	return panicked
-}
-
 // skipIfTruncationDisabled skips the test if truncation detection is disabled
 func skipIfTruncationDisabled(t *testing.T) {
-	if !isTruncationDetectionEnabled() {
-		t.Skip("Skipping truncation test - truncation detection is disabled")
+	if !panikint.Enabled(panikint.Truncation) {
+		t.Skip("Skipping truncation test - truncation detection is disabled, build with -panikint=truncation")
 	}
 }
 
@@ -37,7 +23,7 @@ func TestInt64ToInt32Overflow(t *testing.T) {
 			t.Fatal("Expected panic for int64 to int32 overflow")
 		}
 	}()
-	var large int64 = 0x100000000
+	large := opaque(int64(0x100000000))
 	_ = int32(large)
 }
 
@@ -48,7 +34,7 @@ func TestInt64ToInt32Underflow(t *testing.T) {
 			t.Fatal("Expected panic for int64 to int32 underflow")
 		}
 	}()
-	var large int64 = -0x100000000
+	large := opaque(int64(-0x100000000))
 	_ = int32(large)
 }
 
@@ -59,7 +45,7 @@ func TestInt32ToInt16Overflow(t *testing.T) {
 			t.Fatal("Expected panic for int32 to int16 overflow")
 		}
 	}()
-	var large int32 = 0x10000
+	large := opaque(int32(0x10000))
 	_ = int16(large)
 }
 
@@ -70,7 +56,7 @@ func TestInt32ToInt16Underflow(t *testing.T) {
 			t.Fatal("Expected panic for int32 to int16 underflow")
 		}
 	}()
-	var large int32 = -0x10000
+	large := opaque(int32(-0x10000))
 	_ = int16(large)
 }
 
@@ -81,7 +67,7 @@ func TestInt16ToInt8Overflow(t *testing.T) {
 			t.Fatal("Expected panic for int16 to int8 overflow")
 		}
 	}()
-	var large int16 = 0x100
+	large := opaque(int16(0x100))
 	_ = int8(large)
 }
 
@@ -92,7 +78,7 @@ func TestInt16ToInt8Underflow(t *testing.T) {
 			t.Fatal("Expected panic for int16 to int8 underflow")
 		}
 	}()
-	var large int16 = -0x100
+	large := opaque(int16(-0x100))
 	_ = int8(large)
 }
 
@@ -103,7 +89,7 @@ func TestUint64ToUint32Overflow(t *testing.T) {
 			t.Fatal("Expected panic for uint64 to uint32 overflow")
 		}
 	}()
-	var large uint64 = 0x100000000
+	large := opaque(uint64(0x100000000))
 	_ = uint32(large)
 }
 
@@ -114,7 +100,7 @@ func TestUint32ToUint16Overflow(t *testing.T) {
 			t.Fatal("Expected panic for uint32 to uint16 overflow")
 		}
 	}()
-	var large uint32 = 0x10000
+	large := opaque(uint32(0x10000))
 	_ = uint16(large)
 }
 
@@ -125,7 +111,7 @@ func TestUint16ToUint8Overflow(t *testing.T) {
 			t.Fatal("Expected panic for uint16 to uint8 overflow")
 		}
 	}()
-	var large uint16 = 0x100
+	large := opaque(uint16(0x100))
 	_ = uint8(large)
 }
 
@@ -136,7 +122,7 @@ func TestIntToInt32OnLargeValues(t *testing.T) {
 			t.Fatal("Expected panic for int to int32 on large values")
 		}
 	}()
-	var large int = 0x100000000
+	large := opaque(int(0x100000000))
 	_ = int32(large)
 }
 
@@ -147,7 +133,7 @@ func TestIntToInt16OnLargeValues(t *testing.T) {
 			t.Fatal("Expected panic for int to int16 on large values")
 		}
 	}()
-	var large int = 0x10000
+	large := opaque(int(0x10000))
 	_ = int16(large)
 }
 
@@ -158,7 +144,7 @@ func TestIntToInt8OnLargeValues(t *testing.T) {
 			t.Fatal("Expected panic for int to int8 on large values")
 		}
 	}()
-	var large int = 0x100
+	large := opaque(int(0x100))
 	_ = int8(large)
 }
 
@@ -169,7 +155,7 @@ func TestUintToUint32OnLargeValues(t *testing.T) {
 			t.Fatal("Expected panic for uint to uint32 on large values")
 		}
 	}()
-	var large uint = 0x100000000
+	large := opaque(uint(0x100000000))
 	_ = uint32(large)
 }
 
@@ -180,7 +166,7 @@ func TestUintToUint16OnLargeValues(t *testing.T) {
 			t.Fatal("Expected panic for uint to uint16 on large values")
 		}
 	}()
-	var large uint = 0x10000
+	large := opaque(uint(0x10000))
 	_ = uint16(large)
 }
 
@@ -191,7 +177,7 @@ func TestUintToUint8OnLargeValues(t *testing.T) {
 			t.Fatal("Expected panic for uint to uint8 on large values")
 		}
 	}()
-	var large uint = 0x100
+	large := opaque(uint(0x100))
 	_ = uint8(large)
 }
 
@@ -202,7 +188,7 @@ func TestSignedToUnsignedNegative(t *testing.T) {
 			t.Fatal("Expected panic for signed to unsigned with negative values")
 		}
 	}()
-	var negative int32 = -1
+	negative := opaque(int32(-1))
 	_ = uint32(negative)
 }
 
@@ -213,7 +199,7 @@ func TestUnsignedToSigned(t *testing.T) {
 			t.Fatal("Expected panic for unsigned to signed with large values")
 		}
 	}()
-	var unsigned uint32 = 0xFFFFFFFF
+	unsigned := opaque(uint32(0xFFFFFFFF))
 	_ = int32(unsigned)
 }
 
@@ -224,7 +210,7 @@ func TestInt16ToUint16Negative(t *testing.T) {
 			t.Fatal("Expected panic for int16 to uint16 with negative values")
 		}
 	}()
-	var negative int16 = -1
+	negative := opaque(int16(-1))
 	_ = uint16(negative)
 }
 
@@ -235,7 +221,7 @@ func TestInt8ToUint8Negative(t *testing.T) {
 			t.Fatal("Expected panic for int8 to uint8 with negative values")
 		}
 	}()
-	var negative int8 = -1
+	negative := opaque(int8(-1))
 	_ = uint8(negative)
 }
 
@@ -246,7 +232,7 @@ func TestComplexTruncationChain(t *testing.T) {
 			t.Fatal("Expected panic for complex truncation chain")
 		}
 	}()
-	var start int64 = 0x123456789ABCDEF
+	start := opaque(int64(0x123456789ABCDEF))
 	var step1 int32 = int32(start)
 	var step2 int16 = int16(step1)
 	_ = int8(step2)
@@ -273,7 +259,7 @@ func TestBufferSizeVulnerability(t *testing.T) {
 			t.Fatal("Expected panic for buffer size vulnerability")
 		}
 	}()
-	var requestedSize int64 = 0x200000000
+	requestedSize := opaque(int64(0x200000000))
 	var actualSize int32 = int32(requestedSize)
 	_ = actualSize
 }
@@ -285,7 +271,7 @@ func TestArrayIndexTruncation(t *testing.T) {
 			t.Fatal("Expected panic for array index truncation")
 		}
 	}()
-	var largeIndex int64 = 0x80000000
+	largeIndex := opaque(int64(0x80000000))
 	var truncatedIndex int32 = int32(largeIndex)
 	_ = truncatedIndex
 }
@@ -297,7 +283,7 @@ func TestMemoryOffsetTruncation(t *testing.T) {
 			t.Fatal("Expected panic for memory offset truncation")
 		}
 	}()
-	var offset int64 = 0x180000000
+	offset := opaque(int64(0x180000000))
 	var truncatedOffset int32 = int32(offset)
 	_ = truncatedOffset
 }
@@ -309,7 +295,7 @@ func TestSecurityBoundaryTruncation(t *testing.T) {
 			t.Fatal("Expected panic for security boundary truncation")
 		}
 	}()
-	var securityLimit int64 = 0x7FFFFFFF + 1000
+	securityLimit := opaque(int64(0x7FFFFFFF + 1000))
 	var checkedLimit int32 = int32(securityLimit)
 	_ = checkedLimit
 }
@@ -322,7 +308,7 @@ func TestPlatformDependentIntTruncation(t *testing.T) {
 			t.Fatal("Expected panic for platform-dependent int truncation")
 		}
 	}()
-	var a int = 0x80000000
+	a := opaque(int(0x80000000))
 	_ = int32(a)
 }
 
@@ -333,7 +319,7 @@ func TestBoundaryTruncationInt32MaxPlusOne(t *testing.T) {
 			t.Fatal("Expected panic for int32 max+1 boundary truncation")
 		}
 	}()
-	var c int64 = 0x80000000
+	c := opaque(int64(0x80000000))
 	_ = int32(c)
 }
 
@@ -344,7 +330,7 @@ func TestBoundaryTruncationInt32MinMinusOne(t *testing.T) {
 			t.Fatal("Expected panic for int32 min-1 boundary truncation")
 		}
 	}()
-	var e int64 = -0x80000001
+	e := opaque(int64(-0x80000001))
 	_ = int32(e)
 }
 
@@ -355,7 +341,7 @@ func TestBoundaryTruncationInt16MaxPlusOne(t *testing.T) {
 			t.Fatal("Expected panic for int16 max+1 boundary truncation")
 		}
 	}()
-	var g int32 = 0x8000
+	g := opaque(int32(0x8000))
 	_ = int16(g)
 }
 
@@ -366,7 +352,7 @@ func TestBoundaryTruncationInt8MaxPlusOne(t *testing.T) {
 			t.Fatal("Expected panic for int8 max+1 boundary truncation")
 		}
 	}()
-	var i int16 = 0x80
+	i := opaque(int16(0x80))
 	_ = int8(i)
 }
 
@@ -377,7 +363,7 @@ func TestBoundaryTruncationUint32MaxPlusOne(t *testing.T) {
 			t.Fatal("Expected panic for uint32 max+1 boundary truncation")
 		}
 	}()
-	var k uint64 = 0x100000000
+	k := opaque(uint64(0x100000000))
 	_ = uint32(k)
 }
 
@@ -388,7 +374,7 @@ func TestBoundaryTruncationUint16MaxPlusOne(t *testing.T) {
 			t.Fatal("Expected panic for uint16 max+1 boundary truncation")
 		}
 	}()
-	var m uint32 = 0x10000
+	m := opaque(uint32(0x10000))
 	_ = uint16(m)
 }
 
@@ -399,7 +385,7 @@ func TestBoundaryTruncationUint8MaxPlusOne(t *testing.T) {
 			t.Fatal("Expected panic for uint8 max+1 boundary truncation")
 		}
 	}()
-	var o uint16 = 0x100
+	o := opaque(uint16(0x100))
 	_ = uint8(o)
 }
 
@@ -411,7 +397,7 @@ func TestBitOperationTruncation(t *testing.T) {
 			t.Fatal("Expected panic for bit operation truncation")
 		}
 	}()
-	var value int64 = 0x123456789ABCDEF0
+	value := opaque(int64(0x123456789ABCDEF0))
 	var truncated int32 = int32(value)
 	_ = truncated
 }
@@ -423,23 +409,82 @@ func TestChainedTruncationWithBitOps(t *testing.T) {
 			t.Fatal("Expected panic for chained truncation with bit ops")
 		}
 	}()
-	var value int64 = 0x7FFFFFFFFFFFFFFF
+	value := opaque(int64(0x7FFFFFFFFFFFFFFF))
 	var step1 int32 = int32(value >> 16)
 	_ = step1
 }
 
 func TestUintToIntLargeValue(t *testing.T) {
-	// This test may not trigger panic depending on implementation
+	skipIfTruncationDisabled(t)
 	var large uint64 = 0x8000000000000000
-	result := int64(large)
-	_ = result
+	expectPanicMessage(t, "runtime error: uint64(9223372036854775808) changes sign in int64 (converted to -9223372036854775808)", func() {
+		_ = int64(large)
+	})
 }
 
 func TestIntToUintNegativeEdgeCase(t *testing.T) {
-	// This test may not trigger panic depending on implementation
+	skipIfTruncationDisabled(t)
 	var negative int64 = -1
-	result := uint64(negative)
-	_ = result
+	expectPanicMessage(t, "runtime error: int64(-1) changes sign in uint64 (converted to 18446744073709551615)", func() {
+		_ = uint64(negative)
+	})
+}
+
+func TestPlatformIntSignChange(t *testing.T) {
+	skipIfTruncationDisabled(t)
+	var i int = -2
+	err := recoverArithmeticError(t, func() { _ = uint(i) })
+	if err.Kind() != runtime.ArithmeticSignChange || err.DestType() != "uint" {
+		t.Errorf("uint(%d): got %v to %s, want sign change to uint", i, err.Kind(), err.DestType())
+	}
+	var u uint = 1 << (bits.UintSize - 1)
+	recoverArithmeticError(t, func() { _ = int(u) })
+	var u64 uint64 = math.MaxUint64
+	recoverArithmeticError(t, func() { _ = int(u64) })
+	recoverArithmeticError(t, func() { _ = int32(u64) })
+}
+
+func TestSafeSignConversions(t *testing.T) {
+	// These conversions keep the sign and should not panic
+	var i64 int64 = math.MaxInt64
+	if got := uint64(i64); got != 1<<63-1 {
+		t.Fatalf("Expected %d, got %d", uint64(1<<63-1), got)
+	}
+	// This one does not fit in a 32-bit int
+	if !panikint.Enabled(panikint.Int32) {
+		u64 := opaque(uint64(1<<63 - 1))
+		if got := int(u64); uint64(got) != u64 {
+			t.Fatalf("Expected %d, got %d", u64, got)
+		}
+	}
+	var u uint = 42
+	if got := int(u); got != 42 {
+		t.Fatalf("Expected 42, got %d", got)
+	}
+	var i int32 = math.MaxInt32
+	if got := uint32(i); got != math.MaxInt32 {
+		t.Fatalf("Expected %d, got %d", math.MaxInt32, got)
+	}
+}
+
+func TestCompilerConversionsUnchecked(t *testing.T) {
+	// Converting to an interface and indexing a map reinterpret the
+	// bits of negative values internally, which must not be reported.
+	var i8 int8 = -1
+	var x any = i8
+	if x != any(int8(-1)) {
+		t.Fatalf("Expected -1, got %v", x)
+	}
+	m := map[int32]int{-1: 1}
+	var k int32 = -1
+	if m[k] != 1 {
+		t.Fatalf("Expected 1, got %d", m[k])
+	}
+	m64 := map[int64]int{math.MinInt64: 1}
+	var k64 int64 = math.MinInt64
+	if m64[k64] != 1 {
+		t.Fatalf("Expected 1, got %d", m64[k64])
+	}
 }
 
 func TestSafeTruncation(t *testing.T) {
@@ -459,14 +504,51 @@ func TestSafeTruncation(t *testing.T) {
 
 // Suppression directive tests for truncation
 func TestTruncationSuppression_LineAbove(t *testing.T) {
-	// Expect no panic due to suppression marker on previous line
+	// Expect no panic due to suppression directive on previous line
 	var big uint16 = 300
-	// truncation_false_positive
+	//panikint:ignore truncation
 	_ = uint8(big)
 }
 
 func TestTruncationSuppression_SameLine(t *testing.T) {
-	// Expect no panic due to suppression marker on same line
+	// Expect no panic due to suppression directive on same line
 	var big uint16 = 300
-	_ = uint8(big) // truncation_false_positive
+	_ = uint8(big) //panikint:ignore truncation
+}
+
+func TestTruncationMessageOperands(t *testing.T) {
+	skipIfTruncationDisabled(t)
+	var u16 uint16 = 300
+	expectPanicMessage(t, "runtime error: uint16(300) cannot fit in uint8 (truncated to 44)", func() {
+		_ = uint8(u16)
+	})
+	var i32 int32 = -1
+	expectPanicMessage(t, "runtime error: int32(-1) cannot fit in uint16 (truncated to 65535)", func() {
+		_ = uint16(i32)
+	})
+	var i64 int64 = 200
+	expectPanicMessage(t, "runtime error: int64(200) cannot fit in int8 (truncated to -56)", func() {
+		_ = int8(i64)
+	})
+}
+
+func TestArithmeticErrorConversionKinds(t *testing.T) {
+	skipIfTruncationDisabled(t)
+	var i32 int32 = 70000
+	err := recoverArithmeticError(t, func() { _ = uint16(i32) })
+	if err.Kind() != runtime.ArithmeticTruncation || !errors.Is(err, runtime.ErrTruncation) {
+		t.Errorf("uint16(%d): Kind() = %v, want truncation", i32, err.Kind())
+	}
+	if err.Op() != "conversion" || err.SourceType() != "int32" || err.DestType() != "uint16" {
+		t.Errorf("uint16(%d): got %s from %s to %s", i32, err.Op(), err.SourceType(), err.DestType())
+	}
+	if x, y := err.Operands(); x != i32 || y != nil {
+		t.Errorf("uint16(%d): Operands() = %v, %v", i32, x, y)
+	}
+
+	var i64 int64 = -1
+	err = recoverArithmeticError(t, func() { _ = uint8(i64) })
+	if err.Kind() != runtime.ArithmeticTruncation || errors.Is(err, runtime.ErrSignChange) {
+		t.Errorf("uint8(%d): Kind() = %v, want truncation", i64, err.Kind())
+	}
 }
@@ -0,0 +1,121 @@
+package tests
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// seqNum wraps around on purpose, like a sequence number.
+//
+//panikint:wrapping
+type seqNum uint8
+
+type (
+	//panikint:wrapping
+	hash16 int16
+	plain8 int8
+)
+
+func addGenericWrapping[T ~uint8 | ~int16](a, b T) T { return a + b }
+
+type wrappingCounter[T ~uint8 | ~int16] struct{ n T }
+
+func (c wrappingCounter[T]) add(x T) T { return c.n + x }
+
+func TestWrappingTypeUnchecked(t *testing.T) {
+	var n seqNum = 255
+	if got := n + 1; got != 0 {
+		t.Errorf("seqNum(255) + 1 = %d, want 0", got)
+	}
+	var h hash16 = 30000
+	if got := h*7 - 3; got != 13389 {
+		t.Errorf("hash16(30000)*7 - 3 = %d, want 13389", got)
+	}
+	h = -32768
+	if got := -h; got != -32768 {
+		t.Errorf("-hash16(-32768) = %d, want -32768", got)
+	}
+	if got := addGenericWrapping[seqNum](200, 100); got != 44 {
+		t.Errorf("addGenericWrapping[seqNum](200, 100) = %d, want 44", got)
+	}
+	if got := addGenericWrapping[hash16](32767, 1); got != -32768 {
+		t.Errorf("addGenericWrapping[hash16](32767, 1) = %d, want -32768", got)
+	}
+	if got := (wrappingCounter[seqNum]{255}).add(3); got != 2 {
+		t.Errorf("wrappingCounter[seqNum]{255}.add(3) = %d, want 2", got)
+	}
+	// Other types with the same underlying type are still checked,
+	// including in the same generic function.
+	var p plain8 = 127
+	var u uint8 = 200
+	recoverArithmeticError(t, func() { _ = p + 1 })
+	recoverArithmeticError(t, func() { _ = addGenericWrapping(u, u) })
+	recoverArithmeticError(t, func() { _ = (wrappingCounter[uint8]{u}).add(u) })
+}
+
+func TestWrappingTypeOtherPackage(t *testing.T) {
+	const src = `package main
+
+import (
+	"fmt"
+
+	"example.com/probe/seq"
+)
+
+func add[T ~uint8](x, y T) T { return x + y }
+
+func main() {
+	fmt.Println(seq.Next(255), add[seq.Num](200, 100))
+	var big int64 = 300
+	fmt.Println(seq.Num(big))
+}
+`
+	cmd := probeCommand(t, src, "run", "-panikint=all", ".")
+	if err := os.Mkdir(filepath.Join(cmd.Dir, "seq"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	const seqSrc = `package seq
+
+// Num is a sequence number.
+//
+//panikint:wrapping
+type Num uint8
+
+func Next(n Num) Num { return n + 1 }
+`
+	if err := os.WriteFile(filepath.Join(cmd.Dir, "seq", "seq.go"), []byte(seqSrc), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	out, err := cmd.CombinedOutput()
+	// Conversions into the type are still checked,
+	// and reported with its name.
+	const want = "panic: runtime error: int64(300) cannot fit in seq.Num (truncated to 44)"
+	if err == nil || !strings.Contains(string(out), "0 44\n"+want) {
+		t.Errorf("got %v, want output %q and a panic with %q:\n%s", err, "0 44\n", want, out)
+	}
+}
+
+func TestWrappingDirectiveErrors(t *testing.T) {
+	for _, tt := range []struct {
+		decl, want string
+	}{
+		{"//panikint:wrapping\ntype t string\n", "main.go:3:3: //panikint:wrapping directive on non-integer type t"},
+		{"//panikint:wrapping\n//panikint:wrapping\ntype t int\n", "main.go:4:3: repeated //panikint:wrapping directive"},
+		{"//panikint:wrapping now\ntype t int\n", "main.go:3:3: usage: //panikint:wrapping"},
+		{"//panikint:wrapping\ntype t = int\n", "main.go:3:3: misplaced //panikint:wrapping directive"},
+		{"//panikint:wrapping\nvar v int\n", "main.go:3:3: misplaced //panikint:wrapping directive"},
+		{"//panikint:wrapping\nfunc f() {}\n", "main.go:3:3: misplaced //panikint:wrapping directive"},
+	} {
+		src := "package main\n\n" + tt.decl + "\nfunc main() {}\n"
+		out, err := buildOutput(t, src)
+		if err == nil {
+			t.Errorf("%q: build succeeded, want error", tt.decl)
+			continue
+		}
+		if !strings.Contains(out, tt.want) {
+			t.Errorf("%q: missing %q in output:\n%s", tt.decl, tt.want, out)
+		}
+	}
+}